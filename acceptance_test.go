@@ -138,7 +138,7 @@ func (d driver) WriteToSource(t *testing.T, records []opencdc.Record) []opencdc.
 	listRespMap := make(map[string]hubspot.ListResponseResult)
 
 	for _, listRespResult := range listResp.Results {
-		listRespResultName, ok := listRespResult["properties"].(map[string]any)[testEmailFieldName].(string)
+		listRespResultName, ok := listRespResult.Property(testEmailFieldName)
 		if !ok {
 			t.Errorf("list resp result email is not a string or doesn't exist")
 		}
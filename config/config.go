@@ -16,10 +16,19 @@
 package config
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/validator"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
 )
 
 const (
@@ -29,10 +38,42 @@ const (
 	KeyResource = "resource"
 	// KeyMaxRetries is a config name for max retries.
 	KeyMaxRetries = "maxRetries"
+	// KeyHubDBTableID is a config name for a HubDB table id.
+	KeyHubDBTableID = "hubdbTableId"
+	// KeyInitialRetryDelay is a config name for an initial retry delay.
+	KeyInitialRetryDelay = "initialRetryDelay"
+	// KeyMaxRetryDelay is a config name for a max retry delay.
+	KeyMaxRetryDelay = "maxRetryDelay"
+	// KeyRetryOnStatusCodes is a config name for status codes that trigger a retry.
+	KeyRetryOnStatusCodes = "retryOnStatusCodes"
+	// KeyInsecureSkipVerify is a config name for skipping the TLS certificate verification.
+	KeyInsecureSkipVerify = "insecureSkipVerify"
+	// KeyHTTPProxy is a config name for an HTTP proxy URL.
+	KeyHTTPProxy = "httpProxy"
 )
 
-// DefaultMaxRetries is a default MaxRetries's value used if the MaxRetries field is empty.
-const DefaultMaxRetries = 4
+const (
+	// DefaultMaxRetries is a default MaxRetries's value used if the MaxRetries field is empty.
+	DefaultMaxRetries = 4
+	// DefaultInitialRetryDelay is a default InitialRetryDelay's value used if the InitialRetryDelay field is empty.
+	DefaultInitialRetryDelay = time.Second
+	// DefaultMaxRetryDelay is a default MaxRetryDelay's value used if the MaxRetryDelay field is empty.
+	DefaultMaxRetryDelay = time.Second * 30
+)
+
+// DefaultRetryOnStatusCodes is a default RetryOnStatusCodes's value used if the RetryOnStatusCodes field is empty.
+var DefaultRetryOnStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusServiceUnavailable,
+}
+
+const (
+	// minRetryStatusCode is the minimum status code that can trigger a retry.
+	minRetryStatusCode = 400
+	// maxRetryStatusCode is the maximum status code that can trigger a retry.
+	maxRetryStatusCode = 599
+)
 
 // Config contains configurable values
 // shared between source and destination.
@@ -44,14 +85,32 @@ type Config struct {
 	// MaxRetries is the number of HubSpot API request retries attempts
 	// that will be tried before giving up if a request fails.
 	MaxRetries int `key:"maxRetries" validate:"gte=1"`
+	// HubDBTableID is the id of a HubDB table which rows the connector will work with.
+	// It's required if the Resource is cms.hubdb.rows.
+	HubDBTableID string `key:"hubdbTableId" validate:"required_if=Resource cms.hubdb.rows"`
+	// InitialRetryDelay is the minimum time to wait before retrying a failed request.
+	InitialRetryDelay time.Duration `key:"initialRetryDelay" validate:"gt=0,lt=5m"`
+	// MaxRetryDelay is the maximum time to wait before retrying a failed request.
+	MaxRetryDelay time.Duration `key:"maxRetryDelay" validate:"gtefield=InitialRetryDelay,lt=5m"`
+	// RetryOnStatusCodes holds HTTP status codes of HubSpot API responses that trigger a retry.
+	RetryOnStatusCodes []int `key:"retryOnStatusCodes"`
+	// InsecureSkipVerify determines whether the TLS certificate of the HubSpot API is not verified.
+	InsecureSkipVerify bool `key:"insecureSkipVerify"`
+	// HTTPProxy is the URL of a proxy that HubSpot API requests are sent through.
+	HTTPProxy string `key:"httpProxy"`
 }
 
 // Parse seeks to parse a provided map[string]string into a Config struct.
 func Parse(cfg map[string]string) (Config, error) {
 	config := Config{
-		AccessToken: cfg[KeyAccessToken],
-		Resource:    cfg[KeyResource],
-		MaxRetries:  DefaultMaxRetries,
+		AccessToken:       cfg[KeyAccessToken],
+		Resource:          cfg[KeyResource],
+		MaxRetries:        DefaultMaxRetries,
+		HubDBTableID:      cfg[KeyHubDBTableID],
+		InitialRetryDelay: DefaultInitialRetryDelay,
+		MaxRetryDelay:     DefaultMaxRetryDelay,
+		// clone the default value so that it cannot be modified through the config.
+		RetryOnStatusCodes: slices.Clone(DefaultRetryOnStatusCodes),
 	}
 
 	// parse maxRetries if it's not empty.
@@ -64,9 +123,149 @@ func Parse(cfg map[string]string) (Config, error) {
 		config.MaxRetries = maxRetries
 	}
 
+	// parse initialRetryDelay if it's not empty.
+	if initialRetryDelayStr := cfg[KeyInitialRetryDelay]; initialRetryDelayStr != "" {
+		initialRetryDelay, err := time.ParseDuration(initialRetryDelayStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse initial retry delay: %w", err)
+		}
+
+		config.InitialRetryDelay = initialRetryDelay
+	}
+
+	// parse maxRetryDelay if it's not empty.
+	if maxRetryDelayStr := cfg[KeyMaxRetryDelay]; maxRetryDelayStr != "" {
+		maxRetryDelay, err := time.ParseDuration(maxRetryDelayStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse max retry delay: %w", err)
+		}
+
+		config.MaxRetryDelay = maxRetryDelay
+	}
+
+	// parse retryOnStatusCodes if it's not empty.
+	if retryOnStatusCodesStr := cfg[KeyRetryOnStatusCodes]; retryOnStatusCodesStr != "" {
+		retryOnStatusCodes, err := parseStatusCodes(retryOnStatusCodesStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse retry on status codes: %w", err)
+		}
+
+		config.RetryOnStatusCodes = retryOnStatusCodes
+	}
+
+	// parse insecureSkipVerify if it's not empty.
+	if insecureSkipVerifyStr := cfg[KeyInsecureSkipVerify]; insecureSkipVerifyStr != "" {
+		insecureSkipVerify, err := strconv.ParseBool(insecureSkipVerifyStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse insecure skip verify: %w", err)
+		}
+
+		config.InsecureSkipVerify = insecureSkipVerify
+	}
+
+	// parse httpProxy if it's not empty.
+	if httpProxyStr := strings.TrimSpace(cfg[KeyHTTPProxy]); httpProxyStr != "" {
+		if err := validateProxyURL(httpProxyStr); err != nil {
+			return Config{}, err
+		}
+
+		config.HTTPProxy = httpProxyStr
+	}
+
 	if err := validator.ValidateStruct(config); err != nil {
 		return Config{}, fmt.Errorf("validate common config: %w", err)
 	}
 
 	return config, nil
 }
+
+// CheckRetry is a [retryablehttp.CheckRetry] policy that retries requests
+// which responses have one of the RetryOnStatusCodes.
+// Requests that failed without a response are retried by the [retryablehttp.DefaultRetryPolicy].
+func (c Config) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, fmt.Errorf("context error: %w", ctx.Err())
+	}
+
+	if err != nil || resp == nil {
+		shouldRetry, err := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		if err != nil {
+			return false, fmt.Errorf("default retry policy: %w", err)
+		}
+
+		return shouldRetry, nil
+	}
+
+	return slices.Contains(c.RetryOnStatusCodes, resp.StatusCode), nil
+}
+
+// HTTPTransport returns a transport that sends requests through the HTTPProxy
+// and skips the TLS certificate verification if the InsecureSkipVerify is set.
+// If neither of them is set, the method returns nil, so that the default transport is used.
+func (c Config) HTTPTransport() http.RoundTripper {
+	if c.HTTPProxy == "" && !c.InsecureSkipVerify {
+		return nil
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+
+	if c.HTTPProxy != "" {
+		// ignore the error cause the URL is validated when the config is parsed.
+		proxyURL, _ := url.Parse(c.HTTPProxy)
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true, //nolint:gosec // it's explicitly requested by the user
+		}
+	}
+
+	return transport
+}
+
+// validateProxyURL makes sure the proxy URL is absolute and has a scheme supported by the [http.Transport].
+func validateProxyURL(str string) error {
+	proxyURL, err := url.Parse(str)
+	if err != nil {
+		return fmt.Errorf("parse http proxy: %w", err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return ErrInvalidHTTPProxy
+	}
+
+	if proxyURL.Host == "" {
+		return ErrInvalidHTTPProxy
+	}
+
+	return nil
+}
+
+// parseStatusCodes parses a comma-separated list of HTTP status codes
+// making sure each of them is a client or server error status code.
+func parseStatusCodes(str string) ([]int, error) {
+	statusCodesStr := strings.Split(str, ",")
+
+	statusCodes := make([]int, 0, len(statusCodesStr))
+	for _, statusCodeStr := range statusCodesStr {
+		statusCode, err := strconv.Atoi(strings.TrimSpace(statusCodeStr))
+		if err != nil {
+			return nil, fmt.Errorf("parse status code: %w", err)
+		}
+
+		if statusCode < minRetryStatusCode || statusCode > maxRetryStatusCode {
+			return nil, &InvalidStatusCodeError{
+				StatusCode: statusCode,
+			}
+		}
+
+		statusCodes = append(statusCodes, statusCode)
+	}
+
+	return statusCodes, nil
+}
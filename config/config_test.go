@@ -15,8 +15,13 @@
 package config
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestParse(t *testing.T) {
@@ -41,12 +46,135 @@ func TestParse(t *testing.T) {
 				},
 			},
 			want: Config{
-				AccessToken: "access_token",
-				Resource:    "crm.contacts",
-				MaxRetries:  DefaultMaxRetries,
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
 			},
 			wantErr: false,
 		},
+		{
+			name: "success_hubdb_rows",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:  "access_token",
+					KeyResource:     "cms.hubdb.rows",
+					KeyHubDBTableID: "123",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "cms.hubdb.rows",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+				HubDBTableID:       "123",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_hubdb_rows_missing_table_id",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "cms.hubdb.rows",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_retry_delays",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:       "access_token",
+					KeyResource:          "crm.contacts",
+					KeyInitialRetryDelay: "2s",
+					KeyMaxRetryDelay:     "1m",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  time.Second * 2,
+				MaxRetryDelay:      time.Minute,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_equal_retry_delays",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:       "access_token",
+					KeyResource:          "crm.contacts",
+					KeyInitialRetryDelay: "5s",
+					KeyMaxRetryDelay:     "5s",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  time.Second * 5,
+				MaxRetryDelay:      time.Second * 5,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_initial_retry_delay",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:       "access_token",
+					KeyResource:          "crm.contacts",
+					KeyInitialRetryDelay: "wrong",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_negative_initial_retry_delay",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:       "access_token",
+					KeyResource:          "crm.contacts",
+					KeyInitialRetryDelay: "-1s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_max_retry_delay_less_than_initial",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:       "access_token",
+					KeyResource:          "crm.contacts",
+					KeyInitialRetryDelay: "10s",
+					KeyMaxRetryDelay:     "5s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_max_retry_delay_too_big",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:   "access_token",
+					KeyResource:      "crm.contacts",
+					KeyMaxRetryDelay: "5m",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
 		{
 			name: "fail_missing_access_token",
 			args: args{
@@ -67,6 +195,116 @@ func TestParse(t *testing.T) {
 			want:    Config{},
 			wantErr: true,
 		},
+		{
+			name: "success_cms_domains",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "cms.domains",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "cms.domains",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_marketing_emails",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "marketing.emails",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "marketing.emails",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_retry_on_status_codes",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyRetryOnStatusCodes: "429, 502,503,504",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: []int{429, 502, 503, 504},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_empty_retry_on_status_codes",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyRetryOnStatusCodes: "",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_retry_on_status_code_success",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyRetryOnStatusCodes: "429,200",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_retry_on_status_code_too_big",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyRetryOnStatusCodes: "601",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_retry_on_status_code_not_a_number",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyRetryOnStatusCodes: "abc",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
 		{
 			name: "fail_unsupported_resource",
 			args: args{
@@ -90,6 +328,76 @@ func TestParse(t *testing.T) {
 			want:    Config{},
 			wantErr: true,
 		},
+		{
+			name: "success_insecure_skip_verify_and_http_proxy",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyInsecureSkipVerify: "true",
+					KeyHTTPProxy:          " http://proxy.example.com:8080 ",
+				},
+			},
+			want: Config{
+				AccessToken:        "access_token",
+				Resource:           "crm.contacts",
+				MaxRetries:         DefaultMaxRetries,
+				InitialRetryDelay:  DefaultInitialRetryDelay,
+				MaxRetryDelay:      DefaultMaxRetryDelay,
+				RetryOnStatusCodes: DefaultRetryOnStatusCodes,
+				InsecureSkipVerify: true,
+				HTTPProxy:          "http://proxy.example.com:8080",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_insecure_skip_verify",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken:        "access_token",
+					KeyResource:           "crm.contacts",
+					KeyInsecureSkipVerify: "maybe",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_http_proxy_without_scheme",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "crm.contacts",
+					KeyHTTPProxy:   "proxy.example.com:8080",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_http_proxy_unsupported_scheme",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "crm.contacts",
+					KeyHTTPProxy:   "ftp://proxy.example.com",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_http_proxy_without_host",
+			args: args{
+				cfg: map[string]string{
+					KeyAccessToken: "access_token",
+					KeyResource:    "crm.contacts",
+					KeyHTTPProxy:   "http://",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -111,3 +419,157 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_CheckRetry(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		RetryOnStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+
+	tests := []struct {
+		name       string
+		resp       *http.Response
+		err        error
+		wantRetry  bool
+		wantErr    bool
+		ctxTimeout bool
+	}{
+		{
+			name:      "retry_configured_status_code",
+			resp:      &http.Response{StatusCode: http.StatusServiceUnavailable},
+			wantRetry: true,
+		},
+		{
+			name:      "skip_not_configured_status_code",
+			resp:      &http.Response{StatusCode: http.StatusBadGateway},
+			wantRetry: false,
+		},
+		{
+			name:      "skip_success_status_code",
+			resp:      &http.Response{StatusCode: http.StatusOK},
+			wantRetry: false,
+		},
+		{
+			name:      "retry_connection_error",
+			err:       errors.New("connection reset by peer"),
+			wantRetry: true,
+		},
+		{
+			name:       "fail_context_canceled",
+			resp:       &http.Response{StatusCode: http.StatusServiceUnavailable},
+			wantRetry:  false,
+			wantErr:    true,
+			ctxTimeout: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			if tt.ctxTimeout {
+				cancel()
+			}
+
+			gotRetry, err := cfg.CheckRetry(ctx, tt.resp, tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckRetry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotRetry != tt.wantRetry {
+				t.Errorf("CheckRetry() = %v, want %v", gotRetry, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestConfig_HTTPTransport_default(t *testing.T) {
+	t.Parallel()
+
+	if transport := (Config{}).HTTPTransport(); transport != nil {
+		t.Errorf("HTTPTransport() = %v, want nil", transport)
+	}
+}
+
+func TestConfig_HTTPTransport_httpProxy(t *testing.T) {
+	t.Parallel()
+
+	// the proxy receives requests to any host, so no request reaches the network.
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	cfg := Config{HTTPProxy: proxy.URL}
+
+	client := &http.Client{Transport: cfg.HTTPTransport()}
+
+	resp, err := client.Get("http://api.hubapi.com/integrations/v1/me")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+	resp.Body.Close()
+
+	if want := "http://api.hubapi.com/integrations/v1/me"; proxiedURL != want {
+		t.Errorf("proxied URL = %q, want %q", proxiedURL, want)
+	}
+}
+
+func TestConfig_HTTPTransport_insecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	// the test server uses a self-signed certificate.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name               string
+		insecureSkipVerify bool
+		wantErr            bool
+	}{
+		{
+			name:               "success_insecure_skip_verify",
+			insecureSkipVerify: true,
+		},
+		{
+			name:               "fail_unknown_authority",
+			insecureSkipVerify: false,
+			wantErr:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := Config{InsecureSkipVerify: tt.insecureSkipVerify}
+
+			client := &http.Client{}
+			if transport := cfg.HTTPTransport(); transport != nil {
+				client.Transport = transport
+			}
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidHTTPProxy occurs when the httpProxy is not an absolute URL with a supported scheme.
+var ErrInvalidHTTPProxy = errors.New(`httpProxy must be an absolute URL with one of the "http", "https", "socks5" schemes`)
+
+// InvalidStatusCodeError occurs when a status code that triggers a retry
+// is not a client or server error status code.
+type InvalidStatusCodeError struct {
+	StatusCode int
+}
+
+// Error returns a formated error message for the [InvalidStatusCodeError].
+func (e *InvalidStatusCodeError) Error() string {
+	return fmt.Sprintf("status code %d must be between %d and %d", e.StatusCode, minRetryStatusCode, maxRetryStatusCode)
+}
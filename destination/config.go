@@ -0,0 +1,241 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package destination
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/destination/writer"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+)
+
+const (
+	// ConfigKeyBatchUpsertProperty is a config name for a batch upsert property.
+	ConfigKeyBatchUpsertProperty = "batchUpsertProperty"
+	// ConfigKeyWriteMode is a config name for a write mode.
+	ConfigKeyWriteMode = "writeMode"
+	// ConfigKeyHubDBAutoPublish is a config name for a HubDB auto publish field.
+	ConfigKeyHubDBAutoPublish = "hubdbAutoPublish"
+	// ConfigKeyTimeoutPerRecord is a config name for a timeout per record.
+	ConfigKeyTimeoutPerRecord = "timeoutPerRecord"
+	// ConfigKeyBatchSize is a config name for a batch size.
+	ConfigKeyBatchSize = "batchSize"
+	// ConfigKeyAutoPublish is a config name for an auto publish field.
+	ConfigKeyAutoPublish = "autoPublish"
+	// ConfigKeyIdempotencyKey is a config name for an idempotency key.
+	ConfigKeyIdempotencyKey = "idempotencyKey"
+	// ConfigKeyDryRun is a config name for a dry run field.
+	ConfigKeyDryRun = "dryRun"
+	// ConfigKeyCreateAssociations is a config name for a create associations field.
+	ConfigKeyCreateAssociations = "createAssociations"
+	// ConfigKeyResourceField is a config name for a resource field.
+	ConfigKeyResourceField = "resourceField"
+)
+
+const (
+	// defaultWriteMode is a default value for the WriteMode field.
+	defaultWriteMode = writer.WriteModeAll
+	// defaultTimeoutPerRecord is a default value for the TimeoutPerRecord field.
+	defaultTimeoutPerRecord = time.Second * 10
+	// defaultBatchSize is a default value for the BatchSize field.
+	defaultBatchSize = 1
+	// maxBatchSize is the maximum number of items HubSpot batch endpoints accept at once.
+	maxBatchSize = 100
+)
+
+// hubDBResources holds HubDB resources that support auto publishing.
+var hubDBResources = map[string]struct{}{
+	"cms.hubdb.tables": {},
+	"cms.hubdb.rows":   {},
+}
+
+// Config holds destination-specific configurable values.
+type Config struct {
+	config.Config
+
+	// BatchUpsertProperty is a unique property that is used to match existing items
+	// when inserting records. If it's set, records are upserted instead of created.
+	// Only CRM resources support this.
+	BatchUpsertProperty string `key:"batchUpsertProperty"`
+	// WriteMode defines which operations are written to HubSpot, the others are skipped.
+	// It's one of "all", "create", "update", "delete".
+	WriteMode writer.WriteMode `key:"writeMode"`
+	// HubDBAutoPublish determines whether the HubDB table with the HubDBTableID
+	// will be published on teardown. Only HubDB resources support this.
+	HubDBAutoPublish bool `key:"hubdbAutoPublish"`
+	// TimeoutPerRecord is the maximum time to write a single record,
+	// after which the write fails and the record can be retried.
+	TimeoutPerRecord time.Duration `key:"timeoutPerRecord"`
+	// BatchSize is the maximum number of records that are written to HubSpot at once.
+	// Only CRM resources support values greater than one.
+	BatchSize int `key:"batchSize"`
+	// AutoPublish determines whether CMS pages are published after each successful create or update.
+	// Only CMS page resources support this.
+	AutoPublish bool `key:"autoPublish"`
+	// IdempotencyKey is the name of a record metadata field which value is sent as the hs_unique_creation_key
+	// property of inserted items, so that replayed records don't create duplicates.
+	// Only CRM resources support this.
+	IdempotencyKey string `key:"idempotencyKey"`
+	// DryRun determines whether records are only structurized and validated
+	// without being written to HubSpot.
+	DryRun bool `key:"dryRun"`
+	// CreateAssociations determines whether the associations key of inserted records' payloads
+	// is created as associations of the created items. Only CRM resources support this.
+	CreateAssociations bool `key:"createAssociations"`
+	// ResourceField is the name of a record metadata field which value is the resource the record is written to
+	// instead of the Resource. Records without this field are skipped.
+	ResourceField string `key:"resourceField"`
+}
+
+// ParseConfig seeks to parse a provided map[string]string into a Config struct.
+func ParseConfig(cfg map[string]string) (Config, error) {
+	commonConfig, err := config.Parse(cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("parse common config: %w", err)
+	}
+
+	destinationConfig := Config{
+		Config:              commonConfig,
+		BatchUpsertProperty: cfg[ConfigKeyBatchUpsertProperty],
+		IdempotencyKey:      cfg[ConfigKeyIdempotencyKey],
+		ResourceField:       strings.TrimSpace(cfg[ConfigKeyResourceField]),
+		WriteMode:           defaultWriteMode,
+		TimeoutPerRecord:    defaultTimeoutPerRecord,
+		BatchSize:           defaultBatchSize,
+	}
+
+	// parse writeMode if it's not empty.
+	if writeModeStr := cfg[ConfigKeyWriteMode]; writeModeStr != "" {
+		destinationConfig.WriteMode = writer.WriteMode(writeModeStr)
+
+		if !destinationConfig.WriteMode.IsValid() {
+			return Config{}, ErrInvalidWriteMode
+		}
+	}
+
+	// parse hubdbAutoPublish if it's not empty.
+	if hubDBAutoPublishStr := cfg[ConfigKeyHubDBAutoPublish]; hubDBAutoPublishStr != "" {
+		hubDBAutoPublish, err := strconv.ParseBool(hubDBAutoPublishStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse hubdb auto publish: %w", err)
+		}
+
+		destinationConfig.HubDBAutoPublish = hubDBAutoPublish
+	}
+
+	// parse autoPublish if it's not empty.
+	if autoPublishStr := cfg[ConfigKeyAutoPublish]; autoPublishStr != "" {
+		autoPublish, err := strconv.ParseBool(autoPublishStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse auto publish: %w", err)
+		}
+
+		destinationConfig.AutoPublish = autoPublish
+	}
+
+	// parse dryRun if it's not empty.
+	if dryRunStr := cfg[ConfigKeyDryRun]; dryRunStr != "" {
+		dryRun, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse dry run: %w", err)
+		}
+
+		destinationConfig.DryRun = dryRun
+	}
+
+	// parse createAssociations if it's not empty.
+	if createAssociationsStr := cfg[ConfigKeyCreateAssociations]; createAssociationsStr != "" {
+		createAssociations, err := strconv.ParseBool(createAssociationsStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse create associations: %w", err)
+		}
+
+		destinationConfig.CreateAssociations = createAssociations
+	}
+
+	// parse timeoutPerRecord if it's not empty.
+	if timeoutPerRecordStr := cfg[ConfigKeyTimeoutPerRecord]; timeoutPerRecordStr != "" {
+		timeoutPerRecord, err := time.ParseDuration(timeoutPerRecordStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse timeout per record: %w", err)
+		}
+
+		if timeoutPerRecord <= 0 {
+			return Config{}, ErrInvalidTimeoutPerRecord
+		}
+
+		destinationConfig.TimeoutPerRecord = timeoutPerRecord
+	}
+
+	// parse batchSize if it's not empty.
+	if batchSizeStr := cfg[ConfigKeyBatchSize]; batchSizeStr != "" {
+		batchSize, err := strconv.Atoi(batchSizeStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse batch size: %w", err)
+		}
+
+		if batchSize < 1 || batchSize > maxBatchSize {
+			return Config{}, ErrInvalidBatchSize
+		}
+
+		destinationConfig.BatchSize = batchSize
+	}
+
+	if destinationConfig.BatchSize > 1 {
+		if _, ok := hubspot.ResourcesBatchCreatePaths[destinationConfig.Resource]; !ok {
+			return Config{}, ErrBatchSizeUnsupportedResource
+		}
+	}
+
+	if destinationConfig.HubDBAutoPublish {
+		if _, ok := hubDBResources[destinationConfig.Resource]; !ok {
+			return Config{}, ErrHubDBAutoPublishUnsupportedResource
+		}
+
+		if destinationConfig.HubDBTableID == "" {
+			return Config{}, ErrHubDBAutoPublishMissingTableID
+		}
+	}
+
+	if destinationConfig.AutoPublish {
+		if _, ok := hubspot.ResourcesPublishPaths[destinationConfig.Resource]; !ok {
+			return Config{}, ErrAutoPublishUnsupportedResource
+		}
+	}
+
+	if destinationConfig.BatchUpsertProperty != "" {
+		if _, ok := hubspot.ResourcesBatchUpsertPaths[destinationConfig.Resource]; !ok {
+			return Config{}, ErrBatchUpsertUnsupportedResource
+		}
+	}
+
+	if destinationConfig.IdempotencyKey != "" {
+		if _, ok := hubspot.ResourcesBatchCreatePaths[destinationConfig.Resource]; !ok {
+			return Config{}, ErrIdempotencyKeyUnsupportedResource
+		}
+	}
+
+	if destinationConfig.CreateAssociations {
+		if _, ok := hubspot.ResourcesAssociationObjectTypes[destinationConfig.Resource]; !ok {
+			return Config{}, ErrCreateAssociationsUnsupportedResource
+		}
+	}
+
+	return destinationConfig, nil
+}
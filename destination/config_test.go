@@ -0,0 +1,606 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package destination
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/destination/writer"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		cfg map[string]string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "success",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_batch_upsert_property",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:        "access_token",
+					config.KeyResource:           "crm.contacts",
+					ConfigKeyBatchUpsertProperty: "email",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				BatchUpsertProperty: "email",
+				WriteMode:           writer.WriteModeAll,
+				TimeoutPerRecord:    defaultTimeoutPerRecord,
+				BatchSize:           defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_timeout_per_record",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "crm.contacts",
+					ConfigKeyTimeoutPerRecord: "1m",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: time.Minute,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_timeout_per_record",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "crm.contacts",
+					ConfigKeyTimeoutPerRecord: "ten seconds",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_zero_timeout_per_record",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "crm.contacts",
+					ConfigKeyTimeoutPerRecord: "0s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_batch_upsert_property_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:        "access_token",
+					config.KeyResource:           "cms.blogs.authors",
+					ConfigKeyBatchUpsertProperty: "email",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_batch_size",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyBatchSize:    "100",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        100,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_batch_size",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyBatchSize:    "ten",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_zero_batch_size",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyBatchSize:    "0",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_too_big_batch_size",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyBatchSize:    "101",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_batch_size_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.domains",
+					ConfigKeyBatchSize:    "2",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_write_mode_all",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWriteMode:    "all",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_write_mode_create",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWriteMode:    "create",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeCreate,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_write_mode_update",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWriteMode:    "update",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeUpdate,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_write_mode_delete",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWriteMode:    "delete",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeDelete,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_write_mode",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWriteMode:    "upsert",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_hubdb_auto_publish",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "cms.hubdb.rows",
+					config.KeyHubDBTableID:    "42",
+					ConfigKeyHubDBAutoPublish: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.hubdb.rows",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+					HubDBTableID:       "42",
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+				HubDBAutoPublish: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_hubdb_auto_publish_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "crm.contacts",
+					ConfigKeyHubDBAutoPublish: "true",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_hubdb_auto_publish_missing_table_id",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "cms.hubdb.tables",
+					ConfigKeyHubDBAutoPublish: "true",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_invalid_hubdb_auto_publish",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "cms.hubdb.tables",
+					config.KeyHubDBTableID:    "42",
+					ConfigKeyHubDBAutoPublish: "maybe",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_idempotency_key",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeyIdempotencyKey: "idempotency.key",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+				IdempotencyKey:   "idempotency.key",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_idempotency_key_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "cms.blogs.posts",
+					ConfigKeyIdempotencyKey: "idempotency.key",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_resource_field",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyResourceField: " opencdc.collection ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+				ResourceField:    "opencdc.collection",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_dry_run",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyDryRun:       "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+				DryRun:           true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_dry_run",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyDryRun:       "maybe",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_create_associations",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "crm.contacts",
+					ConfigKeyCreateAssociations: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:          writer.WriteModeAll,
+				TimeoutPerRecord:   defaultTimeoutPerRecord,
+				BatchSize:          defaultBatchSize,
+				CreateAssociations: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_create_associations_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "cms.forms",
+					ConfigKeyCreateAssociations: "true",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_auto_publish",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.pages.landing",
+					ConfigKeyAutoPublish:  "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.pages.landing",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				WriteMode:        writer.WriteModeAll,
+				TimeoutPerRecord: defaultTimeoutPerRecord,
+				BatchSize:        defaultBatchSize,
+				AutoPublish:      true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_auto_publish_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.blogs.posts",
+					ConfigKeyAutoPublish:  "true",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_invalid_auto_publish",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.pages.site",
+					ConfigKeyAutoPublish:  "maybe",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_missing_access_token",
+			args: args{
+				cfg: map[string]string{
+					config.KeyResource: "crm.contacts",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseConfig(tt.args.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseConfig() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
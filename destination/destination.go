@@ -12,34 +12,41 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:generate mockgen -destination=mock/destination.go -package=mock . Writer
-
 package destination
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
 	"github.com/conduitio-labs/conduit-connector-hubspot/destination/writer"
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/validator"
 	cconfig "github.com/conduitio/conduit-commons/config"
 	"github.com/conduitio/conduit-commons/opencdc"
 	sdk "github.com/conduitio/conduit-connector-sdk"
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+// validateEnvVar is an environment variable that enables the [Destination.Validate] call on configure.
+const validateEnvVar = "CONDUIT_CONNECTOR_VALIDATE"
+
 // Writer is a writer interface needed for the [Destination].
 type Writer interface {
 	Write(ctx context.Context, record opencdc.Record) error
+	Flush(ctx context.Context) error
 }
 
 // Destination is a HubSpot destination plugin.
 type Destination struct {
 	sdk.UnimplementedDestination
 
-	config config.Config
+	config Config
 	writer Writer
+	// hubspotClient is used to publish a HubDB table on teardown.
+	hubspotClient *hubspot.Client
 }
 
 // NewDestination creates a new instance of the [Destination].
@@ -65,49 +72,286 @@ func (d *Destination) Parameters() cconfig.Parameters {
 			Description: "The number of HubSpot API request retries " +
 				"that will be tried before giving up if a request fails.",
 		},
+		config.KeyInitialRetryDelay: {
+			Default:     "1s",
+			Description: "The minimum time to wait before retrying a failed request.",
+		},
+		config.KeyMaxRetryDelay: {
+			Default:     "30s",
+			Description: "The maximum time to wait before retrying a failed request.",
+		},
+		config.KeyRetryOnStatusCodes: {
+			Default: "429,500,503",
+			Description: "The HTTP status codes of HubSpot API responses that trigger a retry. " +
+				"Each of them must be between 400 and 599.",
+		},
+		config.KeyHubDBTableID: {
+			Default:     "",
+			Description: "The id of a HubDB table which rows the connector will work with. Required for cms.hubdb.rows.",
+		},
+		config.KeyInsecureSkipVerify: {
+			Default:     "false",
+			Description: "The field determines whether or not the verification of the HubSpot API TLS certificate is skipped.",
+		},
+		config.KeyHTTPProxy: {
+			Default:     "",
+			Description: "The URL of a proxy that HubSpot API requests are sent through, e.g. http://proxy.example.com:8080.",
+		},
+		ConfigKeyBatchUpsertProperty: {
+			Default: "",
+			Description: "The unique property that is used to match existing items when inserting records. " +
+				"If it's set, records are upserted instead of created. Only CRM resources support this.",
+		},
+		ConfigKeyHubDBAutoPublish: {
+			Default: "false",
+			Description: "The field determines whether or not the HubDB table with the hubdbTableId " +
+				"will be published on teardown. Only HubDB resources support this.",
+		},
+		ConfigKeyAutoPublish: {
+			Default: "false",
+			Description: "The field determines whether or not CMS pages will be published " +
+				"after each successful create or update. Only CMS page resources support this.",
+		},
+		ConfigKeyWriteMode: {
+			Default: string(defaultWriteMode),
+			Description: "The operations that are written to HubSpot, the others are skipped. " +
+				"One of all, create, update, delete.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{"all", "create", "update", "delete"}},
+			},
+		},
+		ConfigKeyTimeoutPerRecord: {
+			Default: defaultTimeoutPerRecord.String(),
+			Description: "The maximum time to write a single record. " +
+				"If it's exceeded, the write fails and the record can be retried.",
+		},
+		ConfigKeyBatchSize: {
+			Default: "1",
+			Description: "The maximum number of records that are written to HubSpot at once, up to 100. " +
+				"Only CRM resources support values greater than 1.",
+		},
+		ConfigKeyIdempotencyKey: {
+			Default: "",
+			Description: "The record metadata field which value is sent as the hs_unique_creation_key property " +
+				"of inserted items, so that replayed records don't create duplicates. Only CRM resources support this.",
+		},
+		ConfigKeyDryRun: {
+			Default: "false",
+			Description: "The field determines whether or not records are only validated without being written " +
+				"to HubSpot. No create, update or delete requests are sent.",
+		},
+		ConfigKeyCreateAssociations: {
+			Default: "false",
+			Description: "The field determines whether or not the associations key of inserted records' payloads, " +
+				"a list of {toObjectType, toObjectId, associationType} objects, is created as associations " +
+				"of the created items. Only CRM resources support this.",
+		},
+		ConfigKeyResourceField: {
+			Default: "",
+			Description: "The name of a record metadata field which value is the HubSpot resource the record " +
+				"is written to instead of the resource. Records without this field are skipped.",
+		},
 	}
 }
 
 // Configure parses and initializes the config.
-func (d *Destination) Configure(_ context.Context, cfg cconfig.Config) (err error) {
-	d.config, err = config.Parse(cfg)
+func (d *Destination) Configure(ctx context.Context, cfg cconfig.Config) (err error) {
+	d.config, err = ParseConfig(cfg)
 	if err != nil {
 		return fmt.Errorf("parse destination config: %w", err)
 	}
 
+	// writes to read-only resources would fail for every record, so they're rejected upfront.
+	if hubspot.ResourcesReadOnly[d.config.Resource] {
+		return &ReadOnlyResourceError{Resource: d.config.Resource}
+	}
+
+	if err := d.validateWriteMode(ctx); err != nil {
+		return fmt.Errorf("validate write mode: %w", err)
+	}
+
+	if os.Getenv(validateEnvVar) == "1" {
+		if err := d.Validate(ctx, cfg); err != nil {
+			return fmt.Errorf("validate destination: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateWriteMode checks whether the resource supports the operations required by the write mode.
+// If the write mode is "all", unsupported operations are only logged,
+// since a pipeline may never send records with them.
+func (d *Destination) validateWriteMode(ctx context.Context) error {
+	var (
+		operations []string
+		// strict determines whether unsupported operations fail the validation.
+		strict = true
+	)
+
+	switch d.config.WriteMode {
+	case writer.WriteModeCreate:
+		operations = []string{validator.OperationCreate}
+	case writer.WriteModeUpdate:
+		operations = []string{validator.OperationUpdate}
+	case writer.WriteModeDelete:
+		operations = []string{validator.OperationDelete}
+	default:
+		operations = []string{validator.OperationCreate, validator.OperationUpdate, validator.OperationDelete}
+		strict = false
+	}
+
+	for _, operation := range operations {
+		// records are upserted instead of created if the batch upsert property is set,
+		// and its support is checked when parsing the config.
+		if operation == validator.OperationCreate && d.config.BatchUpsertProperty != "" {
+			continue
+		}
+
+		err := validator.ValidateResourceSupportsOperation(d.config.Resource, operation)
+		if err == nil {
+			continue
+		}
+
+		if strict {
+			return fmt.Errorf("validate %q operation: %w", operation, err)
+		}
+
+		sdk.Logger(ctx).Warn().Err(err).Msgf("records with the %q operation will fail", operation)
+	}
+
 	return nil
 }
 
 // Open makes sure everything is prepared to write records.
 func (d *Destination) Open(ctx context.Context) error {
-	retryableHTTPClient := retryablehttp.NewClient()
-	retryableHTTPClient.RetryMax = d.config.MaxRetries
-	retryableHTTPClient.Logger = sdk.Logger(ctx)
+	hubspotClient := newHubSpotClient(ctx, d.config)
 
-	hubspotClient := hubspot.NewClient(d.config.AccessToken, retryableHTTPClient.StandardClient())
+	d.hubspotClient = hubspotClient
 
 	d.writer = writer.NewWriter(writer.Params{
-		HubSpotClient: hubspotClient,
-		Resource:      d.config.Resource,
+		HubSpotClient:       hubspotClient,
+		Resource:            d.config.Resource,
+		BatchUpsertProperty: d.config.BatchUpsertProperty,
+		WriteMode:           d.config.WriteMode,
+		BatchSize:           d.config.BatchSize,
+		AutoPublish:         d.config.AutoPublish,
+		IdempotencyKey:      d.config.IdempotencyKey,
+		DryRun:              d.config.DryRun,
+		CreateAssociations:  d.config.CreateAssociations,
+		ResourceField:       d.config.ResourceField,
 	})
 
 	return nil
 }
 
-// Write needs to be overridden in the actual implementation.
+// Validate checks the credentials and the resource of a provided config by listing a single item.
+// It returns the [ErrInvalidAccessToken] or the [ErrInsufficientPermissions]
+// if the HubSpot API responds with 401 or 403 status codes respectively.
+func (d *Destination) Validate(ctx context.Context, cfg map[string]string) error {
+	destinationConfig, err := ParseConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("parse destination config: %w", err)
+	}
+
+	_, err = newHubSpotClient(ctx, destinationConfig).List(ctx, destinationConfig.Resource, &hubspot.ListOptions{
+		Limit: 1,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, hubspot.ErrUnauthorized):
+			return fmt.Errorf("%w: %w", ErrInvalidAccessToken, err)
+		case errors.Is(err, hubspot.ErrForbidden):
+			return fmt.Errorf("%w: %w", ErrInsufficientPermissions, err)
+		}
+
+		return fmt.Errorf("list %q items: %w", destinationConfig.Resource, err)
+	}
+
+	return nil
+}
+
+// Write writes records in batches of the batchSize, each record and each flush within the timeoutPerRecord.
+// If a record fails or times out, the method returns the number of records
+// written before the batch it belongs to.
+// If the dryRun is enabled, the records are only validated and counted as the ones that would have been written.
 func (d *Destination) Write(ctx context.Context, records []opencdc.Record) (int, error) {
+	var written int
 	for i, record := range records {
-		if err := d.writer.Write(ctx, record); err != nil {
-			return i, fmt.Errorf("write record: %w", err)
+		recCtx, cancel := context.WithTimeout(ctx, d.config.TimeoutPerRecord)
+		err := d.writer.Write(recCtx, record)
+		cancel()
+
+		if err != nil {
+			return written, fmt.Errorf("write record: %w", err)
+		}
+
+		if d.config.DryRun {
+			sdk.Logger(ctx).Info().Msgf("dry run: would write record %d", i+1)
+		}
+
+		// the writer may flush records on its own, e.g. when the operation changes,
+		// so the batch is flushed explicitly to know that all its records are written.
+		if i+1-written >= d.config.BatchSize {
+			if err := d.flush(ctx); err != nil {
+				return written, err
+			}
+
+			written = i + 1
 		}
 	}
 
+	if err := d.flush(ctx); err != nil {
+		return written, err
+	}
+
 	return len(records), nil
 }
 
-// Teardown does nothing.
+// flush writes the records buffered by the writer within the timeoutPerRecord.
+func (d *Destination) flush(ctx context.Context) error {
+	flushCtx, cancel := context.WithTimeout(ctx, d.config.TimeoutPerRecord)
+	defer cancel()
+
+	if err := d.writer.Flush(flushCtx); err != nil {
+		return fmt.Errorf("flush records: %w", err)
+	}
+
+	return nil
+}
+
+// newHubSpotClient creates a new [hubspot.Client] with retries based on a provided config.
+func newHubSpotClient(ctx context.Context, cfg Config) *hubspot.Client {
+	retryableHTTPClient := retryablehttp.NewClient()
+	retryableHTTPClient.RetryMax = cfg.MaxRetries
+	retryableHTTPClient.RetryWaitMin = cfg.InitialRetryDelay
+	retryableHTTPClient.RetryWaitMax = cfg.MaxRetryDelay
+	retryableHTTPClient.CheckRetry = cfg.CheckRetry
+	retryableHTTPClient.Logger = sdk.Logger(ctx)
+
+	if transport := cfg.HTTPTransport(); transport != nil {
+		retryableHTTPClient.HTTPClient.Transport = transport
+	}
+
+	hubspotClient := hubspot.NewClient(cfg.AccessToken, retryableHTTPClient.StandardClient())
+	hubspotClient.SetHubDBResource(hubspot.HubDBResource{
+		TableID: cfg.HubDBTableID,
+	})
+
+	return hubspotClient
+}
+
+// Teardown publishes the HubDB table if the hubdbAutoPublish is enabled and the dryRun is not.
 func (d *Destination) Teardown(ctx context.Context) error {
 	sdk.Logger(ctx).Debug().Msg("got teardown")
 
+	if d.config.HubDBAutoPublish && !d.config.DryRun && d.hubspotClient != nil {
+		if err := d.hubspotClient.PublishHubDBTable(ctx, d.config.HubDBTableID); err != nil {
+			return fmt.Errorf("publish hubdb table %q: %w", d.config.HubDBTableID, err)
+		}
+
+		sdk.Logger(ctx).Info().Msgf("published hubdb table %q", d.config.HubDBTableID)
+	}
+
 	return nil
 }
@@ -17,10 +17,10 @@ package destination
 import (
 	"context"
 	"errors"
-	"net/http"
 	"os"
 	"testing"
 
+	"github.com/brianvoe/gofakeit/v6"
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
 	"github.com/conduitio-labs/conduit-connector-hubspot/test"
@@ -162,6 +162,79 @@ func TestDestination_Write_successCreateDelete(t *testing.T) {
 	is.NoErr(err)
 }
 
+func TestDestination_Write_successIdempotencyKeyReplay(t *testing.T) {
+	is := is.New(t)
+
+	// prepare a config, configure and open a new destination
+	config := prepareConfig(t)
+	config[ConfigKeyIdempotencyKey] = "idempotency.key"
+
+	destination := NewDestination()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := destination.Configure(ctx, config)
+	is.NoErr(err)
+
+	err = destination.Open(ctx)
+	is.NoErr(err)
+
+	// create a test opencdc.Record with the idempotency key
+	trc := test.NewRecordCreator(t, testResource, false)
+	testCreateRecord := trc.NewTestCreateRecord()
+	testCreateRecord.Metadata = opencdc.Metadata{"idempotency.key": gofakeit.UUID()}
+
+	// write the test record twice, as if it was replayed after a restart
+	for range 2 {
+		n, err := destination.Write(ctx, []opencdc.Record{testCreateRecord})
+		is.NoErr(err)
+		is.Equal(n, 1)
+	}
+
+	// assert record was created only once
+	tra := test.NewRecordAsserter(t, testResource)
+	is.Equal(tra.Count(testCreateRecord), 1)
+
+	// teardown the destination
+	cancel()
+	err = destination.Teardown(context.Background())
+	is.NoErr(err)
+}
+
+func TestDestination_Teardown_successHubDBAutoPublish(t *testing.T) {
+	is := is.New(t)
+
+	if testAccessToken == "" {
+		t.Skip("HUBSPOT_ACCESS_TOKEN env var must be set")
+	}
+
+	// the table must exist in the HubSpot account, so its id is provided explicitly.
+	tableID := os.Getenv("HUBSPOT_HUBDB_TABLE_ID")
+	if tableID == "" {
+		t.Skip("HUBSPOT_HUBDB_TABLE_ID env var must be set")
+	}
+
+	destination := NewDestination()
+
+	ctx := context.Background()
+
+	err := destination.Configure(ctx, map[string]string{
+		config.KeyAccessToken:     testAccessToken,
+		config.KeyResource:        "cms.hubdb.rows",
+		config.KeyHubDBTableID:    tableID,
+		ConfigKeyHubDBAutoPublish: "true",
+	})
+	is.NoErr(err)
+
+	err = destination.Open(ctx)
+	is.NoErr(err)
+
+	// the teardown publishes the table
+	err = destination.Teardown(ctx)
+	is.NoErr(err)
+}
+
 func TestDestination_Write_failInvalidToken(t *testing.T) {
 	is := is.New(t)
 
@@ -189,9 +262,44 @@ func TestDestination_Write_failInvalidToken(t *testing.T) {
 	is.True(err != nil)
 	is.Equal(n, 0)
 
-	var unexpectedStatusCode *hubspot.UnexpectedStatusCodeError
-	is.True(errors.As(err, &unexpectedStatusCode))
-	is.Equal(unexpectedStatusCode.StatusCode, http.StatusUnauthorized)
+	is.True(errors.Is(err, hubspot.ErrUnauthorized))
+}
+
+func TestDestination_Validate_failInvalidToken(t *testing.T) {
+	is := is.New(t)
+
+	// the config is prepared to skip the test if the HubSpot API is not available
+	cfg := prepareConfig(t)
+	cfg[config.KeyAccessToken] = "invalid"
+
+	destination := &Destination{}
+
+	err := destination.Validate(context.Background(), cfg)
+	is.True(errors.Is(err, ErrInvalidAccessToken))
+}
+
+func TestDestination_Configure_failValidateInvalidToken(t *testing.T) {
+	t.Setenv(validateEnvVar, "1")
+
+	is := is.New(t)
+
+	cfg := prepareConfig(t)
+	cfg[config.KeyAccessToken] = "invalid"
+
+	destination := NewDestination()
+
+	// we expect the validation to fail before any write attempts
+	err := destination.Configure(context.Background(), cfg)
+	is.True(errors.Is(err, ErrInvalidAccessToken))
+}
+
+func TestDestination_Validate_success(t *testing.T) {
+	is := is.New(t)
+
+	destination := &Destination{}
+
+	err := destination.Validate(context.Background(), prepareConfig(t))
+	is.NoErr(err)
 }
 
 func prepareConfig(t *testing.T) map[string]string {
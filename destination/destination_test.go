@@ -16,10 +16,14 @@ package destination
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
-	"github.com/conduitio-labs/conduit-connector-hubspot/destination/mock"
+	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/destination/testutil"
 	"github.com/conduitio-labs/conduit-connector-hubspot/destination/writer"
+	hubspotmock "github.com/conduitio-labs/conduit-connector-hubspot/hubspot/mock"
 	"github.com/conduitio/conduit-commons/opencdc"
 	"github.com/matryer/is"
 	"go.uber.org/mock/gomock"
@@ -30,7 +34,6 @@ func TestDestination_Write_success(t *testing.T) {
 
 	is := is.New(t)
 
-	ctrl := gomock.NewController(t)
 	ctx := context.Background()
 
 	record := opencdc.Record{
@@ -47,16 +50,56 @@ func TestDestination_Write_success(t *testing.T) {
 		},
 	}
 
-	w := mock.NewMockWriter(ctrl)
-	w.EXPECT().Write(ctx, record).Return(nil)
+	w := &testutil.RecordingWriter{}
 
 	d := Destination{
+		config: Config{
+			TimeoutPerRecord: defaultTimeoutPerRecord,
+			BatchSize:        defaultBatchSize,
+		},
 		writer: w,
 	}
 
 	written, err := d.Write(ctx, []opencdc.Record{record})
 	is.NoErr(err)
 	is.Equal(written, 1)
+	is.Equal(w.Created(), []opencdc.Record{record})
+	// the full batch of one record is flushed, and then the rest of the records, which are none.
+	is.Equal(w.Flushes(), 2)
+}
+
+func TestDestination_Write_operations(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	records := []opencdc.Record{
+		{Position: opencdc.Position("1.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("2.0"), Operation: opencdc.OperationUpdate},
+		{Position: opencdc.Position("3.0"), Operation: opencdc.OperationDelete},
+		{Position: opencdc.Position("4.0"), Operation: opencdc.OperationSnapshot},
+	}
+
+	w := &testutil.RecordingWriter{}
+
+	d := Destination{
+		config: Config{
+			TimeoutPerRecord: defaultTimeoutPerRecord,
+			BatchSize:        len(records),
+		},
+		writer: w,
+	}
+
+	written, err := d.Write(ctx, records)
+	is.NoErr(err)
+	is.Equal(written, len(records))
+	is.Equal(w.Records(), records)
+	is.Equal(w.Created(), []opencdc.Record{records[0], records[3]})
+	is.Equal(w.Updated(), []opencdc.Record{records[1]})
+	is.Equal(w.Deleted(), []opencdc.Record{records[2]})
+	is.Equal(w.Flushes(), 2)
 }
 
 func TestDestination_Write_fail(t *testing.T) {
@@ -64,7 +107,6 @@ func TestDestination_Write_fail(t *testing.T) {
 
 	is := is.New(t)
 
-	ctrl := gomock.NewController(t)
 	ctx := context.Background()
 
 	record := opencdc.Record{
@@ -75,14 +117,219 @@ func TestDestination_Write_fail(t *testing.T) {
 		},
 	}
 
-	w := mock.NewMockWriter(ctrl)
-	w.EXPECT().Write(ctx, record).Return(writer.ErrEmptyPayload)
+	w := &testutil.RecordingWriter{
+		WriteFunc: func(context.Context, opencdc.Record) error {
+			return writer.ErrEmptyPayload
+		},
+	}
 
 	d := Destination{
+		config: Config{
+			TimeoutPerRecord: defaultTimeoutPerRecord,
+			BatchSize:        defaultBatchSize,
+		},
 		writer: w,
 	}
 
 	written, err := d.Write(ctx, []opencdc.Record{record})
-	is.Equal(err != nil, true)
+	is.True(errors.Is(err, writer.ErrEmptyPayload))
 	is.Equal(written, 0)
+	is.Equal(w.Flushes(), 0)
+}
+
+func TestDestination_Write_timeoutPerRecord(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	records := []opencdc.Record{
+		{Position: opencdc.Position("1.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("2.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("3.0"), Operation: opencdc.OperationCreate},
+	}
+
+	w := &testutil.RecordingWriter{
+		WriteFunc: func(ctx context.Context, record opencdc.Record) error {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("expected the record context to have a deadline")
+			}
+
+			// the second record blocks until its deadline is exceeded.
+			if string(record.Position) == "2.0" {
+				<-ctx.Done()
+
+				return ctx.Err()
+			}
+
+			return nil
+		},
+	}
+
+	d := Destination{
+		config: Config{
+			TimeoutPerRecord: time.Millisecond * 10,
+			BatchSize:        defaultBatchSize,
+		},
+		writer: w,
+	}
+
+	written, err := d.Write(ctx, records)
+	is.True(errors.Is(err, context.DeadlineExceeded))
+	is.Equal(written, 1)
+	// the third record is never written.
+	is.Equal(w.Records(), records[:2])
+	is.Equal(w.Flushes(), 1)
+}
+
+func TestDestination_Write_batch(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	records := []opencdc.Record{
+		{Position: opencdc.Position("1.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("2.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("3.0"), Operation: opencdc.OperationCreate},
+	}
+
+	w := &testutil.RecordingWriter{}
+	w.FlushFunc = func(context.Context) error {
+		switch len(w.Records()) {
+		case 2:
+			// the batch of the first two records is full.
+			return nil
+		default:
+			// the last record fails when it's flushed after all the records are written.
+			return errors.New("batch create failed")
+		}
+	}
+
+	d := Destination{
+		config: Config{
+			TimeoutPerRecord: defaultTimeoutPerRecord,
+			BatchSize:        2,
+		},
+		writer: w,
+	}
+
+	written, err := d.Write(ctx, records)
+	is.True(err != nil)
+	is.Equal(written, 2)
+	is.Equal(w.Created(), records)
+	is.Equal(w.Flushes(), 2)
+}
+
+func TestDestination_Write_dryRun(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	records := []opencdc.Record{
+		{
+			Position:  opencdc.Position("1.0"),
+			Operation: opencdc.OperationCreate,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+		},
+		{
+			Position:  opencdc.Position("2.0"),
+			Operation: opencdc.OperationUpdate,
+			Key:       opencdc.StructuredData{"id": "1"},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "alice@example.com"}},
+		},
+		{
+			Position:  opencdc.Position("3.0"),
+			Operation: opencdc.OperationDelete,
+			Key:       opencdc.StructuredData{"id": "2"},
+		},
+	}
+
+	// the client mock has no expectations, so any request to HubSpot fails the test.
+	hubspotClient := hubspotmock.NewMockClientInterface(gomock.NewController(t))
+
+	d := Destination{
+		config: Config{
+			TimeoutPerRecord: defaultTimeoutPerRecord,
+			BatchSize:        defaultBatchSize,
+			DryRun:           true,
+		},
+		writer: writer.NewWriter(writer.Params{
+			HubSpotClient: hubspotClient,
+			Resource:      "crm.contacts",
+			DryRun:        true,
+		}),
+	}
+
+	written, err := d.Write(ctx, records)
+	is.NoErr(err)
+	is.Equal(written, len(records))
+}
+
+func TestDestination_Configure_readOnlyResource(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	d := &Destination{}
+
+	err := d.Configure(context.Background(), map[string]string{
+		config.KeyAccessToken: "access_token",
+		config.KeyResource:    "crm.feedbackSubmissions",
+	})
+
+	var readOnlyResourceErr *ReadOnlyResourceError
+	is.True(errors.As(err, &readOnlyResourceErr))
+	is.Equal(readOnlyResourceErr.Resource, "crm.feedbackSubmissions")
+}
+
+func TestDestination_Configure_writeMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resource  string
+		writeMode string
+		wantErr   bool
+	}{
+		{
+			name:      "success_all_partially_supported",
+			resource:  "cms.domains",
+			writeMode: "all",
+		},
+		{
+			name:      "success_delete",
+			resource:  "cms.domains",
+			writeMode: "delete",
+		},
+		{
+			name:      "fail_create_unsupported",
+			resource:  "cms.domains",
+			writeMode: "create",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &Destination{}
+
+			err := d.Configure(context.Background(), map[string]string{
+				config.KeyAccessToken: "access_token",
+				config.KeyResource:    tt.resource,
+				ConfigKeyWriteMode:    tt.writeMode,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Configure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
 }
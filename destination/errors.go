@@ -0,0 +1,60 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package destination
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrBatchUpsertUnsupportedResource occurs when the batchUpsertProperty is set for a resource
+	// that doesn't support batch upsert.
+	ErrBatchUpsertUnsupportedResource = errors.New("batchUpsertProperty is supported only by CRM resources")
+	// ErrInvalidWriteMode occurs when the writeMode is not one of the supported values.
+	ErrInvalidWriteMode = errors.New(`writeMode must be one of "all", "create", "update", "delete"`)
+	// ErrHubDBAutoPublishUnsupportedResource occurs when the hubdbAutoPublish is enabled for a non-HubDB resource.
+	ErrHubDBAutoPublishUnsupportedResource = errors.New("hubdbAutoPublish is supported only by HubDB resources")
+	// ErrHubDBAutoPublishMissingTableID occurs when the hubdbAutoPublish is enabled without the hubdbTableId.
+	ErrHubDBAutoPublishMissingTableID = errors.New("hubdbTableId is required to publish a HubDB table")
+	// ErrAutoPublishUnsupportedResource occurs when the autoPublish is enabled for a non-page resource.
+	ErrAutoPublishUnsupportedResource = errors.New("autoPublish is supported only by CMS page resources")
+	// ErrInvalidAccessToken occurs when the HubSpot API rejects the accessToken.
+	ErrInvalidAccessToken = errors.New("accessToken is invalid or expired")
+	// ErrInsufficientPermissions occurs when the accessToken lacks the scopes required by the resource.
+	ErrInsufficientPermissions = errors.New("accessToken doesn't have the permissions required by the resource")
+	// ErrInvalidTimeoutPerRecord occurs when the timeoutPerRecord is not positive.
+	ErrInvalidTimeoutPerRecord = errors.New("timeoutPerRecord must be greater than zero")
+	// ErrInvalidBatchSize occurs when the batchSize is not between 1 and 100.
+	ErrInvalidBatchSize = errors.New("batchSize must be between 1 and 100")
+	// ErrBatchSizeUnsupportedResource occurs when the batchSize is greater than one for a resource
+	// that doesn't support batch writes.
+	ErrBatchSizeUnsupportedResource = errors.New("batchSize greater than 1 is supported only by CRM resources")
+	// ErrIdempotencyKeyUnsupportedResource occurs when the idempotencyKey is set for a non-CRM resource.
+	ErrIdempotencyKeyUnsupportedResource = errors.New("idempotencyKey is supported only by CRM resources")
+	// ErrCreateAssociationsUnsupportedResource occurs when the createAssociations is enabled
+	// for a resource that doesn't support associations.
+	ErrCreateAssociationsUnsupportedResource = errors.New("createAssociations is supported only by CRM resources")
+)
+
+// ReadOnlyResourceError occurs when the destination is configured with a resource that HubSpot allows only to read.
+type ReadOnlyResourceError struct {
+	Resource string
+}
+
+// Error returns a formated error message for the [ReadOnlyResourceError].
+func (e *ReadOnlyResourceError) Error() string {
+	return fmt.Sprintf("resource %q is read-only", e.Resource)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil provides test doubles for the destination unit tests.
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// RecordingWriter is a destination.Writer that records all the Write calls instead of writing
+// the records to HubSpot. Its zero value is ready to use and succeeds on every call.
+type RecordingWriter struct {
+	// WriteFunc is an optional hook that is called on each Write, its error is returned by the Write.
+	WriteFunc func(ctx context.Context, record opencdc.Record) error
+	// FlushFunc is an optional hook that is called on each Flush, its error is returned by the Flush.
+	FlushFunc func(ctx context.Context) error
+
+	mu      sync.Mutex
+	records []opencdc.Record
+	flushes int
+}
+
+// Write records the record and calls the WriteFunc if it's set.
+// The record is recorded even if the WriteFunc returns an error.
+func (w *RecordingWriter) Write(ctx context.Context, record opencdc.Record) error {
+	w.mu.Lock()
+	w.records = append(w.records, record)
+	w.mu.Unlock()
+
+	if w.WriteFunc != nil {
+		return w.WriteFunc(ctx, record)
+	}
+
+	return nil
+}
+
+// Flush counts the call and calls the FlushFunc if it's set.
+func (w *RecordingWriter) Flush(ctx context.Context) error {
+	w.mu.Lock()
+	w.flushes++
+	w.mu.Unlock()
+
+	if w.FlushFunc != nil {
+		return w.FlushFunc(ctx)
+	}
+
+	return nil
+}
+
+// Records returns all the records passed to the Write, in the order they were written.
+func (w *RecordingWriter) Records() []opencdc.Record {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	records := make([]opencdc.Record, len(w.records))
+	copy(records, w.records)
+
+	return records
+}
+
+// Created returns the written records with the [opencdc.OperationCreate] or [opencdc.OperationSnapshot]
+// operation, the same ones the destination creates.
+func (w *RecordingWriter) Created() []opencdc.Record {
+	return w.filter(opencdc.OperationCreate, opencdc.OperationSnapshot)
+}
+
+// Updated returns the written records with the [opencdc.OperationUpdate] operation.
+func (w *RecordingWriter) Updated() []opencdc.Record {
+	return w.filter(opencdc.OperationUpdate)
+}
+
+// Deleted returns the written records with the [opencdc.OperationDelete] operation.
+func (w *RecordingWriter) Deleted() []opencdc.Record {
+	return w.filter(opencdc.OperationDelete)
+}
+
+// Flushes returns the number of the Flush calls.
+func (w *RecordingWriter) Flushes() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.flushes
+}
+
+// filter returns the written records with any of the operations.
+func (w *RecordingWriter) filter(operations ...opencdc.Operation) []opencdc.Record {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var records []opencdc.Record
+	for _, record := range w.records {
+		for _, operation := range operations {
+			if record.Operation == operation {
+				records = append(records, record)
+
+				break
+			}
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,81 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+)
+
+func TestRecordingWriter(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	records := []opencdc.Record{
+		{Position: opencdc.Position("1.0"), Operation: opencdc.OperationCreate},
+		{Position: opencdc.Position("2.0"), Operation: opencdc.OperationUpdate},
+		{Position: opencdc.Position("3.0"), Operation: opencdc.OperationSnapshot},
+		{Position: opencdc.Position("4.0"), Operation: opencdc.OperationDelete},
+		{Position: opencdc.Position("5.0"), Operation: opencdc.OperationUpdate},
+	}
+
+	w := &RecordingWriter{}
+	for _, record := range records {
+		is.NoErr(w.Write(ctx, record))
+	}
+	is.NoErr(w.Flush(ctx))
+
+	is.Equal(w.Records(), records)
+	is.Equal(w.Created(), []opencdc.Record{records[0], records[2]})
+	is.Equal(w.Updated(), []opencdc.Record{records[1], records[4]})
+	is.Equal(w.Deleted(), []opencdc.Record{records[3]})
+	is.Equal(w.Flushes(), 1)
+}
+
+func TestRecordingWriter_hooks(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	errWrite, errFlush := errors.New("write failed"), errors.New("flush failed")
+
+	w := &RecordingWriter{
+		WriteFunc: func(context.Context, opencdc.Record) error {
+			return errWrite
+		},
+		FlushFunc: func(context.Context) error {
+			return errFlush
+		},
+	}
+
+	record := opencdc.Record{Position: opencdc.Position("1.0"), Operation: opencdc.OperationDelete}
+
+	is.Equal(w.Write(ctx, record), errWrite)
+	is.Equal(w.Flush(ctx), errFlush)
+
+	// the failed calls are recorded as well.
+	is.Equal(w.Deleted(), []opencdc.Record{record})
+	is.Equal(w.Created(), nil)
+	is.Equal(w.Flushes(), 1)
+}
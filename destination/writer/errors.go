@@ -14,7 +14,10 @@
 
 package writer
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrEmptyPayload occurs when there's no payload to insert.
@@ -25,4 +28,41 @@ var (
 	ErrKeyIsNotAString = errors.New("key is not a string")
 	// ErrEmptyKey occurs when a key is empty.
 	ErrEmptyKey = errors.New("key is empty")
+	// ErrUnrecognisedPayloadFormat occurs when raw data is not a valid JSON.
+	ErrUnrecognisedPayloadFormat = errors.New("unrecognised payload format")
+	// ErrMissingIdempotencyKey occurs when a record's metadata doesn't contain the idempotency key.
+	ErrMissingIdempotencyKey = errors.New("record metadata doesn't contain the idempotency key")
+	// ErrInvalidAssociations occurs when a payload's associations are not a list of objects
+	// with non-empty toObjectType, toObjectId and associationType fields.
+	ErrInvalidAssociations = errors.New("associations must be a list of objects " +
+		"with toObjectType, toObjectId and associationType fields")
 )
+
+// UnsupportedOptionError occurs when a record is written to a resource that doesn't support an enabled option.
+type UnsupportedOptionError struct {
+	Option   string
+	Resource string
+}
+
+// Error returns a formated error message for the [UnsupportedOptionError].
+func (e *UnsupportedOptionError) Error() string {
+	return fmt.Sprintf("%s is not supported by resource %q", e.Option, e.Resource)
+}
+
+// AssociationError occurs when a created item cannot be associated with another object.
+type AssociationError struct {
+	ItemID       string
+	ToObjectType string
+	ToObjectID   string
+	Err          error
+}
+
+// Error returns a formated error message for the [AssociationError].
+func (e *AssociationError) Error() string {
+	return fmt.Sprintf("associate item %q with %s %q: %v", e.ItemID, e.ToObjectType, e.ToObjectID, e.Err)
+}
+
+// Unwrap returns the underlying error of the [AssociationError].
+func (e *AssociationError) Unwrap() error {
+	return e.Err
+}
@@ -15,9 +15,14 @@
 package writer
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
@@ -25,23 +30,161 @@ import (
 	sdk "github.com/conduitio/conduit-connector-sdk"
 )
 
+const (
+	// itemsFieldName is a field name that JSON arrays are wrapped into.
+	itemsFieldName = "items"
+	// payloadFieldName is a field name that other non-object JSON values are wrapped into.
+	payloadFieldName = "payload"
+	// propertiesFieldName is a field name that holds item's properties.
+	propertiesFieldName = "properties"
+	// uniqueCreationKeyProperty is a property which HubSpot uses to reject creating the same item twice.
+	uniqueCreationKeyProperty = "hs_unique_creation_key"
+	// associationsFieldName is a payload field name that holds associations of created items.
+	associationsFieldName = "associations"
+)
+
+// WriteMode defines which operations the [Writer] sends to HubSpot.
+type WriteMode string
+
+const (
+	// WriteModeAll allows all operations.
+	WriteModeAll WriteMode = "all"
+	// WriteModeCreate allows only create and snapshot operations.
+	WriteModeCreate WriteMode = "create"
+	// WriteModeUpdate allows only update operations.
+	WriteModeUpdate WriteMode = "update"
+	// WriteModeDelete allows only delete operations.
+	WriteModeDelete WriteMode = "delete"
+)
+
+// IsValid checks whether the write mode is one of the supported ones.
+func (m WriteMode) IsValid() bool {
+	switch m {
+	case WriteModeAll, WriteModeCreate, WriteModeUpdate, WriteModeDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// allows checks whether the write mode allows the provided operation.
+// An empty write mode allows all operations.
+func (m WriteMode) allows(operation opencdc.Operation) bool {
+	switch m {
+	case "", WriteModeAll:
+		return true
+	case WriteModeCreate:
+		return operation == opencdc.OperationCreate || operation == opencdc.OperationSnapshot
+	case WriteModeUpdate:
+		return operation == opencdc.OperationUpdate
+	case WriteModeDelete:
+		return operation == opencdc.OperationDelete
+	default:
+		return false
+	}
+}
+
 // Writer implements a writer logic for HubSpot destination.
 type Writer struct {
-	hubspotClient *hubspot.Client
-	resource      string
+	hubspotClient  hubspot.ClientInterface
+	resource       string
+	createResponse chan<- string
+	// batchUpsertProperty is a unique property that is used to upsert items instead of creating them.
+	batchUpsertProperty string
+	// writeMode defines which operations are sent to HubSpot, the others are skipped.
+	writeMode WriteMode
+	// batchSize is the maximum number of records that are written to HubSpot at once.
+	batchSize int
+	// batch holds the buffered records that haven't been flushed yet.
+	batch batch
+	// autoPublish determines whether created and updated CMS pages are published right away.
+	autoPublish bool
+	// idempotencyKey is a record metadata field which value is used as the unique creation key of inserted items.
+	idempotencyKey string
+	// dryRun determines whether records are only validated without being written to HubSpot.
+	dryRun bool
+	// createAssociations determines whether associations from payloads are created alongside items.
+	createAssociations bool
+	// resourceField is a record metadata field which value is the resource the record is written to.
+	resourceField string
+}
+
+// association is an association of a created item with another CRM object.
+type association struct {
+	toObjectType    string
+	toObjectID      string
+	associationType string
+}
+
+// batch holds buffered records of the same operation.
+// The items hold payloads of inserted and updated records,
+// and the itemIDs hold keys of updated and deleted records.
+type batch struct {
+	operation opencdc.Operation
+	items     []map[string]any
+	itemIDs   []string
+}
+
+// len returns the number of buffered records.
+func (b *batch) len() int {
+	return max(len(b.items), len(b.itemIDs))
+}
+
+// reset removes all the buffered records.
+func (b *batch) reset() {
+	b.items = b.items[:0]
+	b.itemIDs = b.itemIDs[:0]
 }
 
 // Params holds incoming params for the [NewWriter] function.
 type Params struct {
-	HubSpotClient *hubspot.Client
+	HubSpotClient hubspot.ClientInterface
 	Resource      string
+	// CreateResponse is an optional channel the ids of created items are sent to.
+	// If it's set, the caller must receive from it, otherwise writes will block.
+	CreateResponse chan<- string
+	// BatchUpsertProperty is an optional unique property. If it's set,
+	// inserted records are upserted matching existing items by this property.
+	BatchUpsertProperty string
+	// WriteMode is an optional write mode. If it's empty, all operations are written.
+	WriteMode WriteMode
+	// BatchSize is an optional maximum number of records that are written to HubSpot at once.
+	// Records are buffered only if it's greater than one and the resource supports batch endpoints,
+	// otherwise they're written one by one.
+	BatchSize int
+	// AutoPublish determines whether CMS pages are published after they're created or updated.
+	// It's ignored by the other resources.
+	AutoPublish bool
+	// IdempotencyKey is an optional record metadata field name. If it's set, its value is sent
+	// as the hs_unique_creation_key property of inserted items, so that HubSpot doesn't create
+	// the same item twice when records are replayed. Only CRM objects that have this property support it.
+	IdempotencyKey string
+	// DryRun determines whether records are only structurized and validated,
+	// without sending any create, update or delete requests to HubSpot.
+	DryRun bool
+	// CreateAssociations determines whether the associations key of inserted records' payloads
+	// is removed from them and its {toObjectType, toObjectId, associationType} items are created
+	// as associations of the created items. Records are not buffered if it's enabled.
+	CreateAssociations bool
+	// ResourceField is an optional record metadata field name. If it's set, each record is written
+	// to the resource its value holds instead of the Resource, and records without it are skipped.
+	ResourceField string
 }
 
 // NewWriter creates a new instance of the [Writer].
 func NewWriter(params Params) *Writer {
 	return &Writer{
-		hubspotClient: params.HubSpotClient,
-		resource:      params.Resource,
+		hubspotClient:       params.HubSpotClient,
+		resource:            params.Resource,
+		createResponse:      params.CreateResponse,
+		batchUpsertProperty: params.BatchUpsertProperty,
+		writeMode:           params.WriteMode,
+		batchSize:           params.BatchSize,
+		autoPublish:         params.AutoPublish,
+		idempotencyKey:      params.IdempotencyKey,
+		dryRun:              params.DryRun,
+		createAssociations:  params.CreateAssociations,
+		resourceField:       params.ResourceField,
 	}
 }
 
@@ -52,13 +195,63 @@ func NewWriter(params Params) *Writer {
 //     the method will try to update an existing record using the record payload;
 //   - If the operation is [opencdc.OperationDelete]
 //     the method will try to delete an existing record using the record key.
+//
+// Records with operations that are not allowed by the write mode are skipped.
+// If the resourceField is set, records are written to the resource from their metadata,
+// and records without it are skipped.
+// If the dry run is enabled, the records are only validated and nothing is written to HubSpot.
+// If batching is enabled, the records are buffered and written once the batch is full,
+// the operation changes, or the [Writer.Flush] is called.
 func (w *Writer) Write(ctx context.Context, record opencdc.Record) error {
-	err := sdk.Util.Destination.Route(ctx, record,
-		w.insert,
-		w.update,
-		w.delete,
-		w.insert,
-	)
+	if !w.writeMode.allows(record.Operation) {
+		sdk.Logger(ctx).Debug().
+			Str("operation", record.Operation.String()).
+			Str("writeMode", string(w.writeMode)).
+			Msg("skipping record with an operation not allowed by the write mode")
+
+		return nil
+	}
+
+	if w.resourceField != "" {
+		resource := record.Metadata[w.resourceField]
+		if resource == "" {
+			sdk.Logger(ctx).Warn().
+				Str("resourceField", w.resourceField).
+				Msg("skipping record without the resource field in its metadata")
+
+			return nil
+		}
+
+		if err := w.switchResource(ctx, resource, record.Operation); err != nil {
+			return err
+		}
+	}
+
+	if w.dryRun {
+		if err := w.validate(ctx, record); err != nil {
+			return fmt.Errorf("validate record: %w", err)
+		}
+
+		return nil
+	}
+
+	var err error
+	if w.batching() {
+		err = sdk.Util.Destination.Route(ctx, record,
+			w.bufferInsert,
+			w.bufferUpdate,
+			w.bufferDelete,
+			w.bufferInsert,
+		)
+	} else {
+		err = sdk.Util.Destination.Route(ctx, record,
+			w.insert,
+			w.update,
+			w.delete,
+			w.insert,
+		)
+	}
+
 	if err != nil {
 		return fmt.Errorf("route record: %w", err)
 	}
@@ -66,9 +259,295 @@ func (w *Writer) Write(ctx context.Context, record opencdc.Record) error {
 	return nil
 }
 
+// switchResource makes the writer write to the provided resource,
+// flushing the records buffered for the previous one first, so that they're written to it.
+// The method returns an *[hubspot.UnsupportedResourceError] if the resource doesn't support the operation,
+// and an *[UnsupportedOptionError] if it doesn't support one of the enabled options.
+func (w *Writer) switchResource(ctx context.Context, resource string, operation opencdc.Operation) error {
+	if err := w.checkResource(resource, operation); err != nil {
+		return err
+	}
+
+	if resource == w.resource {
+		return nil
+	}
+
+	if err := w.Flush(ctx); err != nil {
+		return fmt.Errorf("flush batch: %w", err)
+	}
+
+	w.resource = resource
+
+	return nil
+}
+
+// checkResource checks whether the resource supports the operation and the enabled options,
+// as the resources from records' metadata are not checked by the destination config.
+func (w *Writer) checkResource(resource string, operation opencdc.Operation) error {
+	var supported bool
+	switch operation {
+	case opencdc.OperationCreate, opencdc.OperationSnapshot:
+		_, supported = hubspot.ResourcesCreatePaths[resource]
+
+	case opencdc.OperationUpdate:
+		_, supported = hubspot.ResourcesUpdatePaths[resource]
+
+	case opencdc.OperationDelete:
+		_, supported = hubspot.ResourcesDeletePaths[resource]
+	}
+
+	if !supported {
+		return &hubspot.UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	options := []struct {
+		name      string
+		enabled   bool
+		resources map[string]string
+	}{
+		{name: "batchUpsertProperty", enabled: w.batchUpsertProperty != "", resources: hubspot.ResourcesBatchUpsertPaths},
+		{name: "idempotencyKey", enabled: w.idempotencyKey != "", resources: hubspot.ResourcesBatchCreatePaths},
+		{name: "createAssociations", enabled: w.createAssociations, resources: hubspot.ResourcesAssociationObjectTypes},
+		{name: "autoPublish", enabled: w.autoPublish, resources: hubspot.ResourcesPublishPaths},
+	}
+
+	for _, option := range options {
+		if _, ok := option.resources[resource]; option.enabled && !ok {
+			return &UnsupportedOptionError{
+				Option:   option.name,
+				Resource: resource,
+			}
+		}
+	}
+
+	return nil
+}
+
+// validate checks a record the same way it's checked before it's written, without sending it to HubSpot.
+// Updated and deleted records must have a non-empty key, and inserted and updated ones a non-empty payload.
+func (w *Writer) validate(ctx context.Context, record opencdc.Record) error {
+	if record.Operation == opencdc.OperationUpdate || record.Operation == opencdc.OperationDelete {
+		keyValue, err := w.getRecordKeyValue(ctx, record.Key)
+		if err != nil {
+			return fmt.Errorf("get key's value: %w", err)
+		}
+
+		if keyValue == "" {
+			return ErrEmptyKey
+		}
+	}
+
+	if record.Operation == opencdc.OperationDelete {
+		return nil
+	}
+
+	payload, err := w.structurizeData(ctx, record.Payload.After)
+	if err != nil {
+		return fmt.Errorf("structurize payload: %w", err)
+	}
+
+	// if payload is empty return empty payload error
+	if payload == nil {
+		return ErrEmptyPayload
+	}
+
+	if record.Operation != opencdc.OperationUpdate {
+		if _, err := w.withIdempotencyKey(record, payload); err != nil {
+			return err
+		}
+
+		if _, _, err := w.extractAssociations(payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes the buffered records to HubSpot using batch endpoints.
+// The buffer is emptied even if the write fails, so that the failed records are not written twice.
+func (w *Writer) Flush(ctx context.Context) error {
+	if w.batch.len() == 0 {
+		return nil
+	}
+
+	defer w.batch.reset()
+
+	switch w.batch.operation {
+	case opencdc.OperationCreate:
+		results, err := w.hubspotClient.BatchCreate(ctx, w.resource, w.batch.items)
+		if err != nil {
+			// HubSpot rejects the whole batch if any of its items has an existing unique creation key,
+			// so the items are created one by one, and the ones that have already been created are skipped.
+			if w.idempotencyKey != "" &&
+				errors.Is(err, &hubspot.UnexpectedStatusCodeError{StatusCode: http.StatusConflict}) {
+				return w.createEach(ctx, w.batch.items)
+			}
+
+			return fmt.Errorf("batch create %q items: %w", w.resource, err)
+		}
+
+		for _, result := range results {
+			itemID, _ := result[hubspot.ResultsFieldID].(string)
+			if err := w.sendCreateResponse(ctx, itemID); err != nil {
+				return err
+			}
+		}
+
+	case opencdc.OperationUpdate:
+		inputs := make([]hubspot.BatchUpdateRequestInput, len(w.batch.itemIDs))
+		for i, itemID := range w.batch.itemIDs {
+			inputs[i] = hubspot.BatchUpdateRequestInput{
+				ID:         itemID,
+				Properties: w.batch.items[i],
+			}
+		}
+
+		if _, err := w.hubspotClient.BatchUpdate(ctx, w.resource, inputs); err != nil {
+			return fmt.Errorf("batch update %q items: %w", w.resource, err)
+		}
+
+	case opencdc.OperationDelete:
+		if err := w.hubspotClient.BatchDelete(ctx, w.resource, w.batch.itemIDs); err != nil {
+			return fmt.Errorf("batch delete %q items: %w", w.resource, err)
+		}
+	}
+
+	sdk.Logger(ctx).Debug().Int("count", w.batch.len()).Msgf("flushed %q items", w.resource)
+
+	return nil
+}
+
+// createEach creates the items one by one, it's used when a batch of items cannot be created at once.
+func (w *Writer) createEach(ctx context.Context, items []map[string]any) error {
+	for _, item := range items {
+		if err := w.create(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	sdk.Logger(ctx).Debug().Int("count", len(items)).Msgf("created %q items one by one", w.resource)
+
+	return nil
+}
+
+// batching checks whether records are buffered and written in batches.
+// Records are not buffered if associations are created, as they need the ids of the created items.
+func (w *Writer) batching() bool {
+	if w.batchSize <= 1 || w.createAssociations {
+		return false
+	}
+
+	_, ok := hubspot.ResourcesBatchCreatePaths[w.resource]
+
+	return ok
+}
+
+// bufferInsert adds a record to the batch of created items.
+// If the batchUpsertProperty is set, the batch is flushed and the record is upserted right away.
+func (w *Writer) bufferInsert(ctx context.Context, record opencdc.Record) error {
+	if w.batchUpsertProperty != "" {
+		if err := w.Flush(ctx); err != nil {
+			return fmt.Errorf("flush batch: %w", err)
+		}
+
+		return w.insert(ctx, record)
+	}
+
+	payload, err := w.structurizeData(ctx, record.Payload.After)
+	if err != nil {
+		return fmt.Errorf("structurize payload: %w", err)
+	}
+
+	// if payload is empty return empty payload error
+	if payload == nil {
+		return ErrEmptyPayload
+	}
+
+	payload, err = w.withIdempotencyKey(record, payload)
+	if err != nil {
+		return err
+	}
+
+	return w.addToBatch(ctx, opencdc.OperationCreate, "", payload)
+}
+
+// bufferUpdate adds a record to the batch of updated items.
+func (w *Writer) bufferUpdate(ctx context.Context, record opencdc.Record) error {
+	keyValue, err := w.getRecordKeyValue(ctx, record.Key)
+	if err != nil {
+		return fmt.Errorf("get key's value: %w", err)
+	}
+
+	if keyValue == "" {
+		return ErrEmptyKey
+	}
+
+	payload, err := w.structurizeData(ctx, record.Payload.After)
+	if err != nil {
+		return fmt.Errorf("structurize payload: %w", err)
+	}
+
+	// if payload is empty return empty payload error
+	if payload == nil {
+		return ErrEmptyPayload
+	}
+
+	return w.addToBatch(ctx, opencdc.OperationUpdate, keyValue, payload)
+}
+
+// bufferDelete adds a record to the batch of deleted items.
+func (w *Writer) bufferDelete(ctx context.Context, record opencdc.Record) error {
+	keyValue, err := w.getRecordKeyValue(ctx, record.Key)
+	if err != nil {
+		return fmt.Errorf("get key's value: %w", err)
+	}
+
+	if keyValue == "" {
+		return ErrEmptyKey
+	}
+
+	return w.addToBatch(ctx, opencdc.OperationDelete, keyValue, nil)
+}
+
+// addToBatch adds an item with the provided operation to the batch, and flushes the batch once it's full.
+// The batch is flushed before adding the item if it holds another operation or the same item id,
+// so that records are written in order and HubSpot doesn't reject duplicated ids.
+func (w *Writer) addToBatch(
+	ctx context.Context,
+	operation opencdc.Operation,
+	itemID string,
+	payload opencdc.StructuredData,
+) error {
+	if w.batch.len() > 0 &&
+		(w.batch.operation != operation || (itemID != "" && slices.Contains(w.batch.itemIDs, itemID))) {
+		if err := w.Flush(ctx); err != nil {
+			return fmt.Errorf("flush batch: %w", err)
+		}
+	}
+
+	w.batch.operation = operation
+
+	if itemID != "" {
+		w.batch.itemIDs = append(w.batch.itemIDs, itemID)
+	}
+
+	if payload != nil {
+		w.batch.items = append(w.batch.items, payload)
+	}
+
+	if w.batch.len() >= w.batchSize {
+		return w.Flush(ctx)
+	}
+
+	return nil
+}
+
 // insert inserts a record to a destination.
 func (w *Writer) insert(ctx context.Context, record opencdc.Record) error {
-	payload, err := w.structurizeData(record.Payload.After)
+	payload, err := w.structurizeData(ctx, record.Payload.After)
 	if err != nil {
 		return fmt.Errorf("structurize payload: %w", err)
 	}
@@ -78,21 +557,259 @@ func (w *Writer) insert(ctx context.Context, record opencdc.Record) error {
 		return ErrEmptyPayload
 	}
 
-	if err := w.hubspotClient.Create(ctx, w.resource, payload); err != nil {
+	payload, err = w.withIdempotencyKey(record, payload)
+	if err != nil {
+		return err
+	}
+
+	if w.batchUpsertProperty != "" {
+		// contacts are matched by their emails explicitly
+		// to check whether they exist before creating or updating them.
+		if w.resource == hubspot.ContactsResource && w.batchUpsertProperty == hubspot.ContactEmailProperty {
+			return w.upsertContactByEmail(ctx, payload)
+		}
+
+		return w.upsert(ctx, payload)
+	}
+
+	return w.create(ctx, payload)
+}
+
+// create creates an item, associates it with the objects from the payload's associations if they're enabled,
+// and sends its id to the createResponse channel if it's set.
+func (w *Writer) create(ctx context.Context, payload opencdc.StructuredData) error {
+	payload, associations, err := w.extractAssociations(payload)
+	if err != nil {
+		return err
+	}
+
+	itemID, err := w.hubspotClient.Create(ctx, w.resource, payload)
+	if err != nil {
+		// HubSpot rejects items with an existing unique creation key,
+		// which means the record has already been written before it was replayed.
+		if w.idempotencyKey != "" &&
+			errors.Is(err, &hubspot.UnexpectedStatusCodeError{StatusCode: http.StatusConflict}) {
+			sdk.Logger(ctx).Debug().Msgf("skipping %q item that has already been created", w.resource)
+
+			return nil
+		}
+
 		return fmt.Errorf("create %q item: %w", w.resource, err)
 	}
 
+	sdk.Logger(ctx).Debug().Str("id", itemID).Msgf("created %q item", w.resource)
+
+	if err := w.associate(ctx, itemID, associations); err != nil {
+		return err
+	}
+
+	if err := w.publish(ctx, itemID); err != nil {
+		return err
+	}
+
+	return w.sendCreateResponse(ctx, itemID)
+}
+
+// withIdempotencyKey returns a copy of the payload which properties hold the record's idempotency key
+// as the unique creation key. The payload is returned as is if the idempotencyKey is not set.
+// The method returns the [ErrMissingIdempotencyKey] if the record's metadata doesn't contain the key.
+func (w *Writer) withIdempotencyKey(
+	record opencdc.Record,
+	payload opencdc.StructuredData,
+) (opencdc.StructuredData, error) {
+	if w.idempotencyKey == "" {
+		return payload, nil
+	}
+
+	idempotencyKey := record.Metadata[w.idempotencyKey]
+	if idempotencyKey == "" {
+		return nil, ErrMissingIdempotencyKey
+	}
+
+	// the record's payload is copied, so that the record itself is left unchanged.
+	payload = maps.Clone(payload)
+
+	properties, ok := payload[propertiesFieldName].(map[string]any)
+	if !ok {
+		payload[uniqueCreationKeyProperty] = idempotencyKey
+
+		return payload, nil
+	}
+
+	properties = maps.Clone(properties)
+	properties[uniqueCreationKeyProperty] = idempotencyKey
+	payload[propertiesFieldName] = properties
+
+	return payload, nil
+}
+
+// extractAssociations returns a copy of the payload without the associations field and the associations it holds.
+// The payload is returned as is if the createAssociations is disabled or the payload has no associations.
+// The method returns the [ErrInvalidAssociations] if the associations have an unexpected format.
+func (w *Writer) extractAssociations(
+	payload opencdc.StructuredData,
+) (opencdc.StructuredData, []association, error) {
+	if !w.createAssociations {
+		return payload, nil, nil
+	}
+
+	value, ok := payload[associationsFieldName]
+	if !ok {
+		return payload, nil, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, nil, ErrInvalidAssociations
+	}
+
+	associations := make([]association, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, nil, ErrInvalidAssociations
+		}
+
+		toObjectType, _ := fields["toObjectType"].(string)
+		associationType, _ := fields["associationType"].(string)
+
+		// object ids are usually numeric, so they're accepted as numbers as well.
+		toObjectID, err := w.getKeyValue(opencdc.StructuredData{"toObjectId": fields["toObjectId"]})
+		if err != nil || toObjectType == "" || toObjectID == "" || associationType == "" {
+			return nil, nil, ErrInvalidAssociations
+		}
+
+		associations = append(associations, association{
+			toObjectType:    toObjectType,
+			toObjectID:      toObjectID,
+			associationType: associationType,
+		})
+	}
+
+	// the record's payload is copied, so that the record itself is left unchanged.
+	payload = maps.Clone(payload)
+	delete(payload, associationsFieldName)
+
+	return payload, associations, nil
+}
+
+// associate associates a created item with the provided objects.
+// The method returns an *[AssociationError] if any of the associations cannot be created.
+func (w *Writer) associate(ctx context.Context, itemID string, associations []association) error {
+	for _, a := range associations {
+		err := w.hubspotClient.Associate(ctx, w.resource, itemID, a.toObjectType, a.toObjectID, a.associationType)
+		if err != nil {
+			return &AssociationError{
+				ItemID:       itemID,
+				ToObjectType: a.toObjectType,
+				ToObjectID:   a.toObjectID,
+				Err:          err,
+			}
+		}
+
+		sdk.Logger(ctx).Debug().
+			Str("id", itemID).
+			Str("toObjectType", a.toObjectType).
+			Str("toObjectId", a.toObjectID).
+			Msgf("associated %q item", w.resource)
+	}
+
 	return nil
 }
 
-// update updates a record in a destination.
-func (w *Writer) update(ctx context.Context, record opencdc.Record) error {
-	key, err := w.structurizeData(record.Key)
+// publish publishes a CMS page if the autoPublish is enabled, other resources are left as is.
+func (w *Writer) publish(ctx context.Context, itemID string) error {
+	if !w.autoPublish {
+		return nil
+	}
+
+	if _, ok := hubspot.ResourcesPublishPaths[w.resource]; !ok {
+		return nil
+	}
+
+	if err := w.hubspotClient.PublishPage(ctx, w.resource, itemID); err != nil {
+		return fmt.Errorf("publish %q item: %w", w.resource, err)
+	}
+
+	sdk.Logger(ctx).Debug().Str("id", itemID).Msgf("published %q item", w.resource)
+
+	return nil
+}
+
+// sendCreateResponse sends a created item's id to the createResponse channel if it's set.
+func (w *Writer) sendCreateResponse(ctx context.Context, itemID string) error {
+	if w.createResponse == nil {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("send created item's id: %w", ctx.Err())
+
+	case w.createResponse <- itemID:
+	}
+
+	return nil
+}
+
+// upsert creates or updates an item matching it by the batchUpsertProperty.
+func (w *Writer) upsert(ctx context.Context, payload opencdc.StructuredData) error {
+	results, err := w.hubspotClient.BatchUpsert(ctx, w.resource, w.batchUpsertProperty, []map[string]any{payload})
 	if err != nil {
-		return fmt.Errorf("structurize key: %w", err)
+		return fmt.Errorf("upsert %q item: %w", w.resource, err)
+	}
+
+	for _, result := range results {
+		sdk.Logger(ctx).Debug().Any("id", result[hubspot.ResultsFieldID]).Msgf("upserted %q item", w.resource)
+	}
+
+	return nil
+}
+
+// upsertContactByEmail looks up a contact by the payload's email,
+// updates it if it exists, and creates a new one otherwise.
+func (w *Writer) upsertContactByEmail(ctx context.Context, payload opencdc.StructuredData) error {
+	properties, ok := payload[propertiesFieldName].(map[string]any)
+	if !ok {
+		properties = payload
 	}
 
-	keyValue, err := w.getKeyValue(key)
+	email, ok := properties[hubspot.ContactEmailProperty].(string)
+	if !ok || email == "" {
+		return &hubspot.FieldNotExistError{
+			FieldName: hubspot.ContactEmailProperty,
+		}
+	}
+
+	contact, err := w.hubspotClient.GetContactByEmail(ctx, email, nil)
+	if err != nil {
+		var contactNotFoundErr *hubspot.ContactNotFoundError
+		if errors.As(err, &contactNotFoundErr) {
+			return w.create(ctx, payload)
+		}
+
+		return fmt.Errorf("get contact by email: %w", err)
+	}
+
+	contactID, ok := contact[hubspot.ResultsFieldID].(string)
+	if !ok {
+		return &hubspot.FieldNotExistError{
+			FieldName: hubspot.ResultsFieldID,
+		}
+	}
+
+	if err := w.hubspotClient.Update(ctx, w.resource, contactID, payload); err != nil {
+		return fmt.Errorf("update %q item: %w", w.resource, err)
+	}
+
+	sdk.Logger(ctx).Debug().Str("id", contactID).Msgf("updated %q item matched by email", w.resource)
+
+	return nil
+}
+
+// update updates a record in a destination.
+func (w *Writer) update(ctx context.Context, record opencdc.Record) error {
+	keyValue, err := w.getRecordKeyValue(ctx, record.Key)
 	if err != nil {
 		return fmt.Errorf("get key's value: %w", err)
 	}
@@ -101,7 +818,7 @@ func (w *Writer) update(ctx context.Context, record opencdc.Record) error {
 		return ErrEmptyKey
 	}
 
-	payload, err := w.structurizeData(record.Payload.After)
+	payload, err := w.structurizeData(ctx, record.Payload.After)
 	if err != nil {
 		return fmt.Errorf("structurize payload: %w", err)
 	}
@@ -115,17 +832,12 @@ func (w *Writer) update(ctx context.Context, record opencdc.Record) error {
 		return fmt.Errorf("update %q item: %w", w.resource, err)
 	}
 
-	return nil
+	return w.publish(ctx, keyValue)
 }
 
 // delete deletes a record from a destination.
 func (w *Writer) delete(ctx context.Context, record opencdc.Record) error {
-	key, err := w.structurizeData(record.Key)
-	if err != nil {
-		return fmt.Errorf("structurize key: %w", err)
-	}
-
-	keyValue, err := w.getKeyValue(key)
+	keyValue, err := w.getRecordKeyValue(ctx, record.Key)
 	if err != nil {
 		return fmt.Errorf("get key's value: %w", err)
 	}
@@ -142,7 +854,10 @@ func (w *Writer) delete(ctx context.Context, record opencdc.Record) error {
 }
 
 // structurizeData tries to convert [opencdc.Data] to [opencdc.StructuredData].
-func (w *Writer) structurizeData(data opencdc.Data) (opencdc.StructuredData, error) {
+// If the data is a JSON array, it's wrapped as {"items": [...]},
+// if it's any other non-object JSON value, it's wrapped as {"payload": ...}.
+// The method returns the [ErrUnrecognisedPayloadFormat] if the data is not a valid JSON.
+func (w *Writer) structurizeData(ctx context.Context, data opencdc.Data) (opencdc.StructuredData, error) {
 	if data == nil || len(data.Bytes()) == 0 {
 		return nil, nil //nolint:nilnil // ignoring this validation for now
 	}
@@ -152,11 +867,61 @@ func (w *Writer) structurizeData(data opencdc.Data) (opencdc.StructuredData, err
 	}
 
 	structuredData := make(opencdc.StructuredData)
-	if err := json.Unmarshal(data.Bytes(), &structuredData); err != nil {
-		return nil, fmt.Errorf("unmarshal data into structured data: %w", err)
+	if err := json.Unmarshal(data.Bytes(), &structuredData); err == nil {
+		return structuredData, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data.Bytes(), &value); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnrecognisedPayloadFormat, err)
+	}
+
+	if items, ok := value.([]any); ok {
+		sdk.Logger(ctx).Warn().Msgf("the data is a JSON array, wrapping it into the %q field", itemsFieldName)
+
+		return opencdc.StructuredData{itemsFieldName: items}, nil
+	}
+
+	sdk.Logger(ctx).Warn().Msgf("the data is not a JSON object, wrapping it into the %q field", payloadFieldName)
+
+	return opencdc.StructuredData{payloadFieldName: value}, nil
+}
+
+// getRecordKeyValue returns a value of a record's key.
+// If the key is a raw non-JSON value or a JSON string, e.g. 12345, it's used as is,
+// otherwise, the key is structurized and its first field's value is returned.
+func (w *Writer) getRecordKeyValue(ctx context.Context, key opencdc.Data) (string, error) {
+	if rawKey, ok := key.(opencdc.RawData); ok {
+		if keyValue, ok := rawKeyValue(rawKey); ok {
+			return keyValue, nil
+		}
+	}
+
+	structuredKey, err := w.structurizeData(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("structurize key: %w", err)
+	}
+
+	return w.getKeyValue(structuredKey)
+}
+
+// rawKeyValue returns a value of a raw key if it's not empty and isn't a JSON object or array.
+func rawKeyValue(key opencdc.RawData) (string, bool) {
+	trimmedKey := bytes.TrimSpace(key)
+	if len(trimmedKey) == 0 || trimmedKey[0] == '{' || trimmedKey[0] == '[' {
+		return "", false
+	}
+
+	if trimmedKey[0] == '"' {
+		var keyValue string
+		if err := json.Unmarshal(trimmedKey, &keyValue); err != nil {
+			return "", false
+		}
+
+		return keyValue, keyValue != ""
 	}
 
-	return structuredData, nil
+	return string(trimmedKey), true
 }
 
 // getKeyValue returns the first key within the Key structured data.
@@ -0,0 +1,1255 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot/mock"
+	"github.com/conduitio/conduit-commons/opencdc"
+	"go.uber.org/mock/gomock"
+)
+
+// newMockClient creates a new mock of the HubSpot client that accepts any write calls
+// and records the names of the called methods.
+// The GetContactByEmail method returns the provided contact and error.
+func newMockClient(
+	t *testing.T,
+	contact hubspot.ListResponseResult,
+	contactErr error,
+) (*mock.MockClientInterface, func() []string) {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		methods []string
+	)
+
+	record := func(method string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		methods = append(methods, method)
+	}
+
+	client := mock.NewMockClientInterface(gomock.NewController(t))
+
+	client.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, map[string]any) (string, error) {
+			record("Create")
+
+			return "1", nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, string, map[string]any) error {
+			record("Update")
+
+			return nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, string) error {
+			record("Delete")
+
+			return nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		BatchCreate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, items []map[string]any) ([]hubspot.ListResponseResult, error) {
+			record("BatchCreate")
+
+			results := make([]hubspot.ListResponseResult, len(items))
+			for i := range items {
+				results[i] = hubspot.ListResponseResult{"id": strconv.Itoa(i + 1)}
+			}
+
+			return results, nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		BatchUpdate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, []hubspot.BatchUpdateRequestInput) ([]hubspot.ListResponseResult, error) {
+			record("BatchUpdate")
+
+			return nil, nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		BatchDelete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, []string) error {
+			record("BatchDelete")
+
+			return nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		PublishPage(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, string) error {
+			record("PublishPage")
+
+			return nil
+		}).
+		AnyTimes()
+	client.EXPECT().
+		GetContactByEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, []string) (hubspot.ListResponseResult, error) {
+			record("GetContactByEmail")
+
+			return contact, contactErr
+		}).
+		AnyTimes()
+
+	return client, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return slices.Clone(methods)
+	}
+}
+
+// clonePayload returns a deep copy of the payload that keeps the types of its nested values,
+// unlike the [opencdc.StructuredData.Clone], which turns nested maps into StructuredData.
+func clonePayload(payload opencdc.StructuredData) opencdc.StructuredData {
+	cloned, _ := cloneValue(map[string]any(payload)).(map[string]any)
+
+	return cloned
+}
+
+// cloneValue returns a deep copy of the value's nested maps and slices.
+func cloneValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		cloned := make(map[string]any, len(value))
+		for k, v := range value {
+			cloned[k] = cloneValue(v)
+		}
+
+		return cloned
+
+	case []any:
+		cloned := make([]any, len(value))
+		for i, v := range value {
+			cloned[i] = cloneValue(v)
+		}
+
+		return cloned
+
+	default:
+		return value
+	}
+}
+
+func TestWriter_structurizeData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    opencdc.Data
+		want    opencdc.StructuredData
+		wantErr error
+	}{
+		{
+			name: "success_object",
+			data: opencdc.RawData(`{"name":"Bob"}`),
+			want: opencdc.StructuredData{"name": "Bob"},
+		},
+		{
+			name: "success_array",
+			data: opencdc.RawData(`[{"name":"Bob"},{"name":"Alice"}]`),
+			want: opencdc.StructuredData{
+				"items": []any{map[string]any{"name": "Bob"}, map[string]any{"name": "Alice"}},
+			},
+		},
+		{
+			name: "success_string",
+			data: opencdc.RawData(`"Bob"`),
+			want: opencdc.StructuredData{"payload": "Bob"},
+		},
+		{
+			name: "success_number",
+			data: opencdc.RawData(`42`),
+			want: opencdc.StructuredData{"payload": float64(42)},
+		},
+		{
+			name: "success_structured_data",
+			data: opencdc.StructuredData{"name": "Bob"},
+			want: opencdc.StructuredData{"name": "Bob"},
+		},
+		{
+			name: "success_empty",
+			data: opencdc.RawData(nil),
+			want: nil,
+		},
+		{
+			name:    "fail_invalid_json",
+			data:    opencdc.RawData(`{"name":`),
+			wantErr: ErrUnrecognisedPayloadFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &Writer{}
+
+			got, err := w.structurizeData(context.Background(), tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("structurizeData() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("structurizeData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_getRecordKeyValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     opencdc.Data
+		want    string
+		wantErr error
+	}{
+		{
+			name: "success_raw_digits",
+			key:  opencdc.RawData("123"),
+			want: "123",
+		},
+		{
+			name: "success_raw_not_json",
+			key:  opencdc.RawData("not-json"),
+			want: "not-json",
+		},
+		{
+			name: "success_raw_json_string",
+			key:  opencdc.RawData(`"123"`),
+			want: "123",
+		},
+		{
+			name: "success_raw_empty",
+			key:  opencdc.RawData(""),
+			want: "",
+		},
+		{
+			name: "success_raw_json_object",
+			key:  opencdc.RawData(`{"id": "123"}`),
+			want: "123",
+		},
+		{
+			name: "success_structured_data",
+			key:  opencdc.StructuredData{"id": float64(123)},
+			want: "123",
+		},
+		{
+			name:    "fail_raw_composite_key",
+			key:     opencdc.RawData(`{"id": "123", "email": "bob@example.com"}`),
+			wantErr: ErrCompositeKeysNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &Writer{}
+
+			got, err := w.getRecordKeyValue(context.Background(), tt.key)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("getRecordKeyValue() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("getRecordKeyValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_writeMode(t *testing.T) {
+	t.Parallel()
+
+	records := []opencdc.Record{
+		{
+			Operation: opencdc.OperationSnapshot,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+		},
+		{
+			Operation: opencdc.OperationCreate,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "alice@example.com"}},
+		},
+		{
+			Operation: opencdc.OperationUpdate,
+			Key:       opencdc.StructuredData{"id": "1"},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+		},
+		{
+			Operation: opencdc.OperationDelete,
+			Key:       opencdc.StructuredData{"id": "2"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		writeMode WriteMode
+		want      []string
+	}{
+		{
+			name:      "empty",
+			writeMode: "",
+			want:      []string{"Create", "Create", "Update", "Delete"},
+		},
+		{
+			name:      "all",
+			writeMode: WriteModeAll,
+			want:      []string{"Create", "Create", "Update", "Delete"},
+		},
+		{
+			name:      "create",
+			writeMode: WriteModeCreate,
+			want:      []string{"Create", "Create"},
+		},
+		{
+			name:      "update",
+			writeMode: WriteModeUpdate,
+			want:      []string{"Update"},
+		},
+		{
+			name:      "delete",
+			writeMode: WriteModeDelete,
+			want:      []string{"Delete"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, methods := newMockClient(t, nil, nil)
+
+			w := NewWriter(Params{
+				HubSpotClient: client,
+				Resource:      "crm.contacts",
+				WriteMode:     tt.writeMode,
+			})
+
+			for _, record := range records {
+				if err := w.Write(context.Background(), record); err != nil {
+					t.Fatalf("expected error to be nil, but got %v", err)
+				}
+			}
+
+			if got := methods(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("called methods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_autoPublish(t *testing.T) {
+	t.Parallel()
+
+	records := []opencdc.Record{
+		{
+			Operation: opencdc.OperationCreate,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"name": "Home"}},
+		},
+		{
+			Operation: opencdc.OperationUpdate,
+			Key:       opencdc.StructuredData{"id": "1"},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"name": "About"}},
+		},
+		{
+			Operation: opencdc.OperationDelete,
+			Key:       opencdc.StructuredData{"id": "2"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		resource    string
+		autoPublish bool
+		want        []string
+	}{
+		{
+			name:        "landing_page",
+			resource:    "cms.pages.landing",
+			autoPublish: true,
+			want:        []string{"Create", "PublishPage", "Update", "PublishPage", "Delete"},
+		},
+		{
+			name:        "site_page",
+			resource:    "cms.pages.site",
+			autoPublish: true,
+			want:        []string{"Create", "PublishPage", "Update", "PublishPage", "Delete"},
+		},
+		{
+			name:        "disabled",
+			resource:    "cms.pages.site",
+			autoPublish: false,
+			want:        []string{"Create", "Update", "Delete"},
+		},
+		{
+			name:        "not_a_page",
+			resource:    "cms.blogs.posts",
+			autoPublish: true,
+			want:        []string{"Create", "Update", "Delete"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, methods := newMockClient(t, nil, nil)
+
+			w := NewWriter(Params{
+				HubSpotClient: client,
+				Resource:      tt.resource,
+				AutoPublish:   tt.autoPublish,
+			})
+
+			for _, record := range records {
+				if err := w.Write(context.Background(), record); err != nil {
+					t.Fatalf("expected error to be nil, but got %v", err)
+				}
+			}
+
+			if got := methods(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("called methods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_upsertContactByEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		contact    hubspot.ListResponseResult
+		contactErr error
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:    "success_existing_contact",
+			contact: hubspot.ListResponseResult{"id": "1"},
+			want:    []string{"GetContactByEmail", "Update"},
+		},
+		{
+			name:       "success_new_contact",
+			contactErr: &hubspot.ContactNotFoundError{Email: "bob@example.com"},
+			want:       []string{"GetContactByEmail", "Create"},
+		},
+		{
+			name:       "fail_ambiguous_contact",
+			contactErr: &hubspot.AmbiguousContactError{Email: "bob@example.com", Total: 2},
+			want:       []string{"GetContactByEmail"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, methods := newMockClient(t, tt.contact, tt.contactErr)
+
+			w := NewWriter(Params{
+				HubSpotClient:       client,
+				Resource:            hubspot.ContactsResource,
+				BatchUpsertProperty: hubspot.ContactEmailProperty,
+			})
+
+			err := w.Write(context.Background(), opencdc.Record{
+				Operation: opencdc.OperationCreate,
+				Payload: opencdc.Change{
+					After: opencdc.StructuredData{"properties": map[string]any{"email": "bob@example.com"}},
+				},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := methods(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("called methods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_batch(t *testing.T) {
+	t.Parallel()
+
+	create := func(email string) opencdc.Record {
+		return opencdc.Record{
+			Operation: opencdc.OperationCreate,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": email}},
+		}
+	}
+	update := func(id string) opencdc.Record {
+		return opencdc.Record{
+			Operation: opencdc.OperationUpdate,
+			Key:       opencdc.StructuredData{"id": id},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+		}
+	}
+	remove := func(id string) opencdc.Record {
+		return opencdc.Record{
+			Operation: opencdc.OperationDelete,
+			Key:       opencdc.StructuredData{"id": id},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		resource  string
+		batchSize int
+		records   []opencdc.Record
+		want      []string
+	}{
+		{
+			name:      "success_full_batch",
+			resource:  hubspot.ContactsResource,
+			batchSize: 2,
+			records:   []opencdc.Record{create("a@example.com"), create("b@example.com"), create("c@example.com")},
+			want:      []string{"BatchCreate", "BatchCreate"},
+		},
+		{
+			name:      "success_operation_change",
+			resource:  hubspot.ContactsResource,
+			batchSize: 10,
+			records:   []opencdc.Record{create("a@example.com"), update("1"), update("2"), remove("3")},
+			want:      []string{"BatchCreate", "BatchUpdate", "BatchDelete"},
+		},
+		{
+			name:      "success_duplicate_id",
+			resource:  hubspot.ContactsResource,
+			batchSize: 10,
+			records:   []opencdc.Record{update("1"), update("1")},
+			want:      []string{"BatchUpdate", "BatchUpdate"},
+		},
+		{
+			name:      "success_batch_size_one",
+			resource:  hubspot.ContactsResource,
+			batchSize: 1,
+			records:   []opencdc.Record{create("a@example.com"), update("1")},
+			want:      []string{"Create", "Update"},
+		},
+		{
+			name:      "success_unsupported_resource",
+			resource:  "cms.domains",
+			batchSize: 10,
+			records:   []opencdc.Record{create("a@example.com"), remove("1")},
+			want:      []string{"Create", "Delete"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, methods := newMockClient(t, nil, nil)
+
+			w := NewWriter(Params{
+				HubSpotClient: client,
+				Resource:      tt.resource,
+				BatchSize:     tt.batchSize,
+			})
+
+			for _, record := range tt.records {
+				if err := w.Write(context.Background(), record); err != nil {
+					t.Fatalf("expected error to be nil, but got %v", err)
+				}
+			}
+
+			if err := w.Flush(context.Background()); err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			if got := methods(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("called methods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Flush_createResponse(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newMockClient(t, nil, nil)
+
+	createResponse := make(chan string, 2)
+
+	w := NewWriter(Params{
+		HubSpotClient:  client,
+		Resource:       hubspot.ContactsResource,
+		CreateResponse: createResponse,
+		BatchSize:      10,
+	})
+
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		err := w.Write(context.Background(), opencdc.Record{
+			Operation: opencdc.OperationCreate,
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": email}},
+		})
+		if err != nil {
+			t.Fatalf("expected error to be nil, but got %v", err)
+		}
+	}
+
+	if len(createResponse) != 0 {
+		t.Fatalf("expected no created ids before the flush, but got %d", len(createResponse))
+	}
+
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	close(createResponse)
+
+	var got []string
+	for id := range createResponse {
+		got = append(got, id)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("created ids = %v, want %v", got, want)
+	}
+}
+
+func TestWriter_Write_idempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	const idempotencyKey = "idempotency.key"
+
+	tests := []struct {
+		name    string
+		record  opencdc.Record
+		want    map[string]any
+		wantErr error
+	}{
+		{
+			name: "properties",
+			record: opencdc.Record{
+				Operation: opencdc.OperationCreate,
+				Metadata:  opencdc.Metadata{idempotencyKey: "abc"},
+				Payload: opencdc.Change{After: opencdc.StructuredData{
+					"properties": map[string]any{"email": "bob@example.com"},
+				}},
+			},
+			want: map[string]any{
+				"properties": map[string]any{"email": "bob@example.com", "hs_unique_creation_key": "abc"},
+			},
+		},
+		{
+			name: "flat_payload",
+			record: opencdc.Record{
+				Operation: opencdc.OperationSnapshot,
+				Metadata:  opencdc.Metadata{idempotencyKey: "abc"},
+				Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+			},
+			want: map[string]any{"email": "bob@example.com", "hs_unique_creation_key": "abc"},
+		},
+		{
+			name: "missing_metadata",
+			record: opencdc.Record{
+				Operation: opencdc.OperationCreate,
+				Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+			},
+			wantErr: ErrMissingIdempotencyKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]any
+
+			client := mock.NewMockClientInterface(gomock.NewController(t))
+			client.EXPECT().
+				Create(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, item map[string]any) (string, error) {
+					got = item
+
+					return "1", nil
+				}).
+				MaxTimes(1)
+
+			w := NewWriter(Params{
+				HubSpotClient:  client,
+				Resource:       hubspot.ContactsResource,
+				IdempotencyKey: idempotencyKey,
+			})
+
+			before := clonePayload(tt.record.Payload.After.(opencdc.StructuredData))
+
+			err := w.Write(context.Background(), tt.record)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error to be %v, but got %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("created item = %v, want %v", got, tt.want)
+			}
+
+			// the record's payload must be left unchanged.
+			if !reflect.DeepEqual(tt.record.Payload.After, before) {
+				t.Errorf("record payload = %v, want %v", tt.record.Payload.After, before)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_idempotencyKeyConflict(t *testing.T) {
+	t.Parallel()
+
+	client := mock.NewMockClientInterface(gomock.NewController(t))
+	client.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", &hubspot.UnexpectedStatusCodeError{StatusCode: http.StatusConflict}).
+		Times(2)
+
+	record := opencdc.Record{
+		Operation: opencdc.OperationCreate,
+		Metadata:  opencdc.Metadata{"idempotency.key": "abc"},
+		Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+	}
+
+	// the already created item is skipped if the idempotency key is set.
+	w := NewWriter(Params{
+		HubSpotClient:  client,
+		Resource:       hubspot.ContactsResource,
+		IdempotencyKey: "idempotency.key",
+	})
+
+	if err := w.Write(context.Background(), record); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	// otherwise, the conflict fails the write.
+	w = NewWriter(Params{
+		HubSpotClient: client,
+		Resource:      hubspot.ContactsResource,
+	})
+
+	if err := w.Write(context.Background(), record); err == nil {
+		t.Fatalf("expected error, but got nil")
+	}
+}
+
+func TestWriter_Flush_idempotencyKeyConflict(t *testing.T) {
+	t.Parallel()
+
+	// the batch is rejected because the item with the key "abc" has already been created,
+	// so the items are created one by one, and the already created one is skipped.
+	client := mock.NewMockClientInterface(gomock.NewController(t))
+	client.EXPECT().
+		BatchCreate(gomock.Any(), hubspot.ContactsResource, gomock.Any()).
+		Return(nil, &hubspot.UnexpectedStatusCodeError{StatusCode: http.StatusConflict})
+
+	var created []string
+	client.EXPECT().
+		Create(gomock.Any(), hubspot.ContactsResource, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, item map[string]any) (string, error) {
+			email, _ := item["email"].(string)
+			if email == "bob@example.com" {
+				return "", &hubspot.UnexpectedStatusCodeError{StatusCode: http.StatusConflict}
+			}
+
+			created = append(created, email)
+
+			return "2", nil
+		}).
+		Times(2)
+
+	w := NewWriter(Params{
+		HubSpotClient:  client,
+		Resource:       hubspot.ContactsResource,
+		BatchSize:      2,
+		IdempotencyKey: "idempotency.key",
+	})
+
+	records := []opencdc.Record{
+		{
+			Operation: opencdc.OperationCreate,
+			Metadata:  opencdc.Metadata{"idempotency.key": "abc"},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+		},
+		{
+			Operation: opencdc.OperationCreate,
+			Metadata:  opencdc.Metadata{"idempotency.key": "def"},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "alice@example.com"}},
+		},
+	}
+
+	for _, record := range records {
+		if err := w.Write(context.Background(), record); err != nil {
+			t.Fatalf("expected error to be nil, but got %v", err)
+		}
+	}
+
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if want := []string{"alice@example.com"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created = %v, expected %v", created, want)
+	}
+}
+
+func TestWriter_Write_dryRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		records []opencdc.Record
+		wantErr error
+	}{
+		{
+			name: "success",
+			records: []opencdc.Record{
+				{
+					Operation: opencdc.OperationSnapshot,
+					Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+				},
+				{
+					Operation: opencdc.OperationCreate,
+					Payload:   opencdc.Change{After: opencdc.RawData(`{"email":"alice@example.com"}`)},
+				},
+				{
+					Operation: opencdc.OperationUpdate,
+					Key:       opencdc.StructuredData{"id": "1"},
+					Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+				},
+				{
+					Operation: opencdc.OperationDelete,
+					Key:       opencdc.StructuredData{"id": "2"},
+				},
+			},
+		},
+		{
+			name: "fail_empty_payload",
+			records: []opencdc.Record{
+				{
+					Operation: opencdc.OperationCreate,
+				},
+			},
+			wantErr: ErrEmptyPayload,
+		},
+		{
+			name: "fail_empty_key",
+			records: []opencdc.Record{
+				{
+					Operation: opencdc.OperationDelete,
+					Key:       opencdc.StructuredData{"id": ""},
+				},
+			},
+			wantErr: ErrEmptyKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the mock has no expectations, so any request to HubSpot fails the test.
+			w := NewWriter(Params{
+				HubSpotClient: mock.NewMockClientInterface(gomock.NewController(t)),
+				Resource:      "crm.contacts",
+				BatchSize:     2,
+				DryRun:        true,
+			})
+
+			var err error
+			for _, record := range tt.records {
+				if err = w.Write(context.Background(), record); err != nil {
+					break
+				}
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err := w.Flush(context.Background()); err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_createAssociations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		payload          opencdc.StructuredData
+		associateErr     error
+		wantCreated      map[string]any
+		wantAssociations []string
+		wantErr          bool
+	}{
+		{
+			name: "success",
+			payload: opencdc.StructuredData{
+				"properties": map[string]any{"email": "bob@example.com"},
+				"associations": []any{
+					map[string]any{"toObjectType": "companies", "toObjectId": "4", "associationType": "contact_to_company"},
+					map[string]any{"toObjectType": "deals", "toObjectId": float64(7), "associationType": "contact_to_deal"},
+				},
+			},
+			wantCreated:      map[string]any{"properties": map[string]any{"email": "bob@example.com"}},
+			wantAssociations: []string{"companies/4/contact_to_company", "deals/7/contact_to_deal"},
+		},
+		{
+			name:        "success_no_associations",
+			payload:     opencdc.StructuredData{"properties": map[string]any{"email": "bob@example.com"}},
+			wantCreated: map[string]any{"properties": map[string]any{"email": "bob@example.com"}},
+		},
+		{
+			name: "fail_associate",
+			payload: opencdc.StructuredData{
+				"properties": map[string]any{"email": "bob@example.com"},
+				"associations": []any{
+					map[string]any{"toObjectType": "companies", "toObjectId": "4", "associationType": "contact_to_company"},
+				},
+			},
+			associateErr:     hubspot.ErrNotFound,
+			wantCreated:      map[string]any{"properties": map[string]any{"email": "bob@example.com"}},
+			wantAssociations: []string{"companies/4/contact_to_company"},
+			wantErr:          true,
+		},
+		{
+			name: "fail_invalid_associations",
+			payload: opencdc.StructuredData{
+				"properties":   map[string]any{"email": "bob@example.com"},
+				"associations": []any{map[string]any{"toObjectType": "companies"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				gotCreated      map[string]any
+				gotAssociations []string
+			)
+
+			client := mock.NewMockClientInterface(gomock.NewController(t))
+			client.EXPECT().
+				Create(gomock.Any(), hubspot.ContactsResource, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, item map[string]any) (string, error) {
+					gotCreated = item
+
+					return "1", nil
+				}).
+				MaxTimes(1)
+			client.EXPECT().
+				Associate(gomock.Any(), hubspot.ContactsResource, "1", gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, toObjectType, toObjectID, associationType string) error {
+					gotAssociations = append(gotAssociations, toObjectType+"/"+toObjectID+"/"+associationType)
+
+					return tt.associateErr
+				}).
+				AnyTimes()
+
+			w := NewWriter(Params{
+				HubSpotClient:      client,
+				Resource:           hubspot.ContactsResource,
+				BatchSize:          2,
+				CreateAssociations: true,
+			})
+
+			before := clonePayload(tt.payload)
+
+			err := w.Write(context.Background(), opencdc.Record{
+				Operation: opencdc.OperationCreate,
+				Payload:   opencdc.Change{After: tt.payload},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var associationErr *AssociationError
+			if errors.As(err, &associationErr) != (tt.associateErr != nil) {
+				t.Errorf("expected error to be an AssociationError: %t, but got %v", tt.associateErr != nil, err)
+			}
+
+			if !reflect.DeepEqual(gotCreated, tt.wantCreated) {
+				t.Errorf("created item = %v, want %v", gotCreated, tt.wantCreated)
+			}
+
+			if !reflect.DeepEqual(gotAssociations, tt.wantAssociations) {
+				t.Errorf("associations = %v, want %v", gotAssociations, tt.wantAssociations)
+			}
+
+			// the record's payload must be left unchanged.
+			if !reflect.DeepEqual(tt.payload, before) {
+				t.Errorf("record payload = %v, want %v", tt.payload, before)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_resourceField(t *testing.T) {
+	t.Parallel()
+
+	create := func(resource string) opencdc.Record {
+		record := opencdc.Record{
+			Operation: opencdc.OperationCreate,
+			Metadata:  opencdc.Metadata{},
+			Payload:   opencdc.Change{After: opencdc.StructuredData{"name": "bob"}},
+		}
+		if resource != "" {
+			record.Metadata["hubspot.resource"] = resource
+		}
+
+		return record
+	}
+
+	// withOperation changes the record's operation, keeping the key of the item to update or delete.
+	withOperation := func(record opencdc.Record, operation opencdc.Operation) opencdc.Record {
+		record.Operation = operation
+		record.Key = opencdc.RawData("1")
+
+		return record
+	}
+
+	tests := []struct {
+		name      string
+		batchSize int
+		records   []opencdc.Record
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:    "success",
+			records: []opencdc.Record{create("crm.contacts"), create("crm.companies"), create("crm.contacts")},
+			want:    []string{"Create crm.contacts", "Create crm.companies", "Create crm.contacts"},
+		},
+		{
+			name:      "success_batch_flushed_on_resource_change",
+			batchSize: 10,
+			records:   []opencdc.Record{create("crm.contacts"), create("crm.contacts"), create("crm.companies")},
+			want:      []string{"BatchCreate crm.contacts", "BatchCreate crm.companies"},
+		},
+		{
+			name:    "success_missing_resource_field",
+			records: []opencdc.Record{create(""), create("crm.deals")},
+			want:    []string{"Create crm.deals"},
+		},
+		{
+			name:    "success_delete_from_resource_without_create",
+			records: []opencdc.Record{withOperation(create("cms.domains"), opencdc.OperationDelete)},
+			want:    []string{"Delete cms.domains"},
+		},
+		{
+			name:    "fail_unsupported_resource",
+			records: []opencdc.Record{create("crm.unknown")},
+			wantErr: true,
+		},
+		{
+			name:    "fail_update_unsupported_by_resource",
+			records: []opencdc.Record{withOperation(create("cms.hubdb.rows"), opencdc.OperationUpdate)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+
+			client := mock.NewMockClientInterface(gomock.NewController(t))
+			client.EXPECT().
+				Create(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, resource string, _ map[string]any) (string, error) {
+					calls = append(calls, "Create "+resource)
+
+					return "1", nil
+				}).
+				AnyTimes()
+			client.EXPECT().
+				BatchCreate(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, resource string, _ []map[string]any) ([]hubspot.ListResponseResult, error) {
+					calls = append(calls, "BatchCreate "+resource)
+
+					return nil, nil
+				}).
+				AnyTimes()
+			client.EXPECT().
+				Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, resource, _ string) error {
+					calls = append(calls, "Delete "+resource)
+
+					return nil
+				}).
+				AnyTimes()
+
+			w := NewWriter(Params{
+				HubSpotClient: client,
+				Resource:      hubspot.ContactsResource,
+				BatchSize:     tt.batchSize,
+				ResourceField: "hubspot.resource",
+			})
+
+			var err error
+			for _, record := range tt.records {
+				if err = w.Write(context.Background(), record); err != nil {
+					break
+				}
+			}
+
+			if err == nil {
+				err = w.Flush(context.Background())
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var unsupportedResourceErr *hubspot.UnsupportedResourceError
+			if tt.wantErr && !errors.As(err, &unsupportedResourceErr) {
+				t.Errorf("expected *hubspot.UnsupportedResourceError, got %v", err)
+			}
+
+			if !reflect.DeepEqual(calls, tt.want) {
+				t.Errorf("called methods = %v, want %v", calls, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_Write_resourceFieldUnsupportedOption(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		params   Params
+		resource string
+	}{
+		{
+			name:     "batch_upsert_property",
+			params:   Params{BatchUpsertProperty: "email"},
+			resource: "cms.blogs.posts",
+		},
+		{
+			name:     "idempotency_key",
+			params:   Params{IdempotencyKey: "hubspot.key"},
+			resource: "cms.blogs.posts",
+		},
+		{
+			name:     "create_associations",
+			params:   Params{CreateAssociations: true},
+			resource: "cms.blogs.posts",
+		},
+		{
+			name:     "auto_publish",
+			params:   Params{AutoPublish: true},
+			resource: "crm.contacts",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.HubSpotClient = mock.NewMockClientInterface(gomock.NewController(t))
+			params.Resource = hubspot.ContactsResource
+			params.ResourceField = "hubspot.resource"
+
+			err := NewWriter(params).Write(context.Background(), opencdc.Record{
+				Operation: opencdc.OperationCreate,
+				Metadata:  opencdc.Metadata{"hubspot.resource": tt.resource, "hubspot.key": "1"},
+				Payload:   opencdc.Change{After: opencdc.StructuredData{"email": "bob@example.com"}},
+			})
+
+			var unsupportedOptionErr *UnsupportedOptionError
+			if !errors.As(err, &unsupportedOptionErr) {
+				t.Fatalf("expected *UnsupportedOptionError, got %v", err)
+			}
+
+			if unsupportedOptionErr.Resource != tt.resource {
+				t.Errorf("resource = %q, want %q", unsupportedOptionErr.Resource, tt.resource)
+			}
+		})
+	}
+}
+
+func TestWriteMode_IsValid(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []WriteMode{WriteModeAll, WriteModeCreate, WriteModeUpdate, WriteModeDelete} {
+		if !mode.IsValid() {
+			t.Errorf("expected %q to be valid", mode)
+		}
+	}
+
+	for _, mode := range []WriteMode{"", "upsert", "ALL"} {
+		if mode.IsValid() {
+			t.Errorf("expected %q to be invalid", mode)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// associatePath is a path of the endpoint that associates two CRM objects.
+// https://developers.hubspot.com/docs/api/crm/associations/v3
+const associatePath = "/crm/v3/objects/%s/%s/associations/%s/%s/%s"
+
+// ResourcesAssociationObjectTypes holds a mapping of resources that support associations and their object types.
+var ResourcesAssociationObjectTypes = map[string]string{
+	"crm.companies":  "companies",
+	"crm.contacts":   "contacts",
+	"crm.deals":      "deals",
+	"crm.lineItems":  "line_items",
+	"crm.products":   "products",
+	"crm.tickets":    "tickets",
+	"crm.quotes":     "quotes",
+	"crm.calls":      "calls",
+	"crm.emails":     "emails",
+	"crm.meetings":   "meetings",
+	"crm.notes":      "notes",
+	"crm.tasks":      "tasks",
+	"crm.postalMail": "postal_mail",
+	"crm.taskQueues": "task_queues",
+}
+
+// Associate associates an existing item of a specific resource with another CRM object,
+// for example, a contact with a company using the "contact_to_company" association type.
+// The method raises an *[UnsupportedResourceError] if a provided resource doesn't support associations.
+func (c *Client) Associate(
+	ctx context.Context,
+	resource, itemID, toObjectType, toObjectID, associationType string,
+) error {
+	objectType, ok := ResourcesAssociationObjectTypes[resource]
+	if !ok {
+		return &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	resourcePath := fmt.Sprintf(associatePath,
+		objectType,
+		url.PathEscape(itemID),
+		url.PathEscape(toObjectType),
+		url.PathEscape(toObjectID),
+		url.PathEscape(associationType),
+	)
+
+	req, err := c.newRequest(ctx, http.MethodPut, resourcePath, nil)
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Associate(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1/associations/companies/4/contact_to_company",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected method to be %s, but got %s", http.MethodPut, r.Method)
+			}
+
+			fmt.Fprint(w, `{"id":"1","associations":{"companies":{"results":[{"id":"4","type":"contact_to_company"}]}}}`)
+		})
+
+	err := client.Associate(context.Background(), "crm.contacts", "1", "companies", "4", "contact_to_company")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Associate_taskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues/1/associations/contacts/4/task_queue_to_contact",
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected method to be %s, but got %s", http.MethodPut, r.Method)
+			}
+
+			fmt.Fprint(w, `{"id":"1","associations":{"contacts":{"results":[{"id":"4","type":"task_queue_to_contact"}]}}}`)
+		})
+
+	err := client.Associate(context.Background(), "crm.taskQueues", "1", "contacts", "4", "task_queue_to_contact")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Associate_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1/associations/companies/4/contact_to_company",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status":"error","message":"resource not found"}`)
+		})
+
+	err := client.Associate(context.Background(), "crm.contacts", "1", "companies", "4", "contact_to_company")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected error to be ErrNotFound, but got %v", err)
+	}
+}
+
+func TestClient_Associate_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	err := client.Associate(context.Background(), "cms.forms", "1", "companies", "4", "contact_to_company")
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
@@ -0,0 +1,677 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// batchReadLimit is the maximum number of inputs that can be sent within a single batch read request.
+	batchReadLimit = 100
+	// batchWriteLimit is the maximum number of inputs that can be sent within a single batch write request.
+	batchWriteLimit = 100
+	// defaultUpdateManyMaxConcurrency is the default maximum number of batch update requests
+	// the [Client.UpdateMany] sends concurrently.
+	defaultUpdateManyMaxConcurrency = 4
+	// defaultBulkCreateMaxConcurrency is the default maximum number of batch create requests
+	// the [Client.BulkCreate] sends concurrently.
+	defaultBulkCreateMaxConcurrency = 4
+	// propertiesFieldName is a name of the field that holds the item's properties.
+	propertiesFieldName = "properties"
+)
+
+// ResourcesBatchReadPaths holds a mapping of supported resources and their batch read endpoints.
+var ResourcesBatchReadPaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/companies
+	"crm.companies": "/crm/v3/objects/companies/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/contacts
+	"crm.contacts": "/crm/v3/objects/contacts/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/deals
+	"crm.deals": "/crm/v3/objects/deals/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": "/crm/v3/objects/feedback_submissions/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/line-items
+	"crm.lineItems": "/crm/v3/objects/line_items/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/products
+	"crm.products": "/crm/v3/objects/products/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/tickets
+	"crm.tickets": "/crm/v3/objects/tickets/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/quotes
+	"crm.quotes": "/crm/v3/objects/quotes/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/calls
+	"crm.calls": "/crm/v3/objects/calls/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/email
+	"crm.emails": "/crm/v3/objects/emails/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/meetings
+	"crm.meetings": "/crm/v3/objects/meetings/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/notes
+	"crm.notes": "/crm/v3/objects/notes/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/tasks
+	"crm.tasks": "/crm/v3/objects/tasks/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/batch/read",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/batch/read",
+}
+
+// ResourcesBatchUpsertPaths holds a mapping of supported resources and their batch upsert endpoints.
+var ResourcesBatchUpsertPaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/companies
+	"crm.companies": "/crm/v3/objects/companies/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/contacts
+	"crm.contacts": "/crm/v3/objects/contacts/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/deals
+	"crm.deals": "/crm/v3/objects/deals/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": "/crm/v3/objects/feedback_submissions/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/line-items
+	"crm.lineItems": "/crm/v3/objects/line_items/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/products
+	"crm.products": "/crm/v3/objects/products/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/tickets
+	"crm.tickets": "/crm/v3/objects/tickets/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/quotes
+	"crm.quotes": "/crm/v3/objects/quotes/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/calls
+	"crm.calls": "/crm/v3/objects/calls/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/email
+	"crm.emails": "/crm/v3/objects/emails/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/meetings
+	"crm.meetings": "/crm/v3/objects/meetings/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/notes
+	"crm.notes": "/crm/v3/objects/notes/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/tasks
+	"crm.tasks": "/crm/v3/objects/tasks/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/batch/upsert",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/batch/upsert",
+}
+
+// ResourcesBatchCreatePaths holds a mapping of supported resources and their batch create endpoints.
+var ResourcesBatchCreatePaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/companies
+	"crm.companies": "/crm/v3/objects/companies/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/contacts
+	"crm.contacts": "/crm/v3/objects/contacts/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/deals
+	"crm.deals": "/crm/v3/objects/deals/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": "/crm/v3/objects/feedback_submissions/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/line-items
+	"crm.lineItems": "/crm/v3/objects/line_items/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/products
+	"crm.products": "/crm/v3/objects/products/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/tickets
+	"crm.tickets": "/crm/v3/objects/tickets/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/quotes
+	"crm.quotes": "/crm/v3/objects/quotes/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/calls
+	"crm.calls": "/crm/v3/objects/calls/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/email
+	"crm.emails": "/crm/v3/objects/emails/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/meetings
+	"crm.meetings": "/crm/v3/objects/meetings/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/notes
+	"crm.notes": "/crm/v3/objects/notes/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/tasks
+	"crm.tasks": "/crm/v3/objects/tasks/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/batch/create",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/batch/create",
+}
+
+// ResourcesBatchUpdatePaths holds a mapping of supported resources and their batch update endpoints.
+var ResourcesBatchUpdatePaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/companies
+	"crm.companies": "/crm/v3/objects/companies/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/contacts
+	"crm.contacts": "/crm/v3/objects/contacts/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/deals
+	"crm.deals": "/crm/v3/objects/deals/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": "/crm/v3/objects/feedback_submissions/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/line-items
+	"crm.lineItems": "/crm/v3/objects/line_items/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/products
+	"crm.products": "/crm/v3/objects/products/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/tickets
+	"crm.tickets": "/crm/v3/objects/tickets/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/quotes
+	"crm.quotes": "/crm/v3/objects/quotes/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/calls
+	"crm.calls": "/crm/v3/objects/calls/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/email
+	"crm.emails": "/crm/v3/objects/emails/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/meetings
+	"crm.meetings": "/crm/v3/objects/meetings/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/notes
+	"crm.notes": "/crm/v3/objects/notes/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/tasks
+	"crm.tasks": "/crm/v3/objects/tasks/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/batch/update",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/batch/update",
+}
+
+// ResourcesBatchArchivePaths holds a mapping of supported resources and their batch archive endpoints.
+var ResourcesBatchArchivePaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/companies
+	"crm.companies": "/crm/v3/objects/companies/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/contacts
+	"crm.contacts": "/crm/v3/objects/contacts/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/deals
+	"crm.deals": "/crm/v3/objects/deals/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": "/crm/v3/objects/feedback_submissions/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/line-items
+	"crm.lineItems": "/crm/v3/objects/line_items/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/products
+	"crm.products": "/crm/v3/objects/products/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/tickets
+	"crm.tickets": "/crm/v3/objects/tickets/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/quotes
+	"crm.quotes": "/crm/v3/objects/quotes/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/calls
+	"crm.calls": "/crm/v3/objects/calls/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/email
+	"crm.emails": "/crm/v3/objects/emails/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/meetings
+	"crm.meetings": "/crm/v3/objects/meetings/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/notes
+	"crm.notes": "/crm/v3/objects/notes/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/tasks
+	"crm.tasks": "/crm/v3/objects/tasks/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/batch/archive",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/batch/archive",
+}
+
+// BatchReadRequest is a request model for batch read endpoints.
+type BatchReadRequest struct {
+	IDProperty string                  `json:"idProperty,omitempty"`
+	Inputs     []BatchReadRequestInput `json:"inputs"`
+	Properties []string                `json:"properties,omitempty"`
+}
+
+// BatchReadRequestInput is an input model for the [BatchReadRequest].
+type BatchReadRequestInput struct {
+	ID string `json:"id"`
+}
+
+// BatchResponse is a response model for batch endpoints.
+// If some of the inputs fail, the response contains the errors for them.
+type BatchResponse struct {
+	Status    string               `json:"status"`
+	Results   []ListResponseResult `json:"results"`
+	NumErrors int                  `json:"numErrors,omitempty"`
+	Errors    []BatchResponseError `json:"errors,omitempty"`
+}
+
+// BatchResponseError is an error model for the [BatchResponse].
+type BatchResponseError struct {
+	Status   string              `json:"status"`
+	Category string              `json:"category"`
+	Message  string              `json:"message"`
+	Context  map[string][]string `json:"context,omitempty"`
+}
+
+// BatchUpsertRequest is a request model for batch upsert endpoints.
+type BatchUpsertRequest struct {
+	Inputs []BatchUpsertRequestInput `json:"inputs"`
+}
+
+// BatchUpsertRequestInput is an input model for the [BatchUpsertRequest].
+type BatchUpsertRequestInput struct {
+	ID         string         `json:"id"`
+	IDProperty string         `json:"idProperty"`
+	Properties map[string]any `json:"properties"`
+}
+
+// BatchCreateRequest is a request model for batch create endpoints.
+type BatchCreateRequest struct {
+	Inputs []BatchCreateRequestInput `json:"inputs"`
+}
+
+// BatchCreateRequestInput is an input model for the [BatchCreateRequest].
+type BatchCreateRequestInput struct {
+	Properties map[string]any `json:"properties"`
+}
+
+// BatchUpdateRequest is a request model for batch update endpoints.
+type BatchUpdateRequest struct {
+	Inputs []BatchUpdateRequestInput `json:"inputs"`
+}
+
+// BatchUpdateRequestInput is an input model for the [BatchUpdateRequest].
+type BatchUpdateRequestInput struct {
+	ID         string         `json:"id"`
+	Properties map[string]any `json:"properties"`
+}
+
+// BatchArchiveRequest is a request model for batch archive endpoints.
+type BatchArchiveRequest struct {
+	Inputs []BatchReadRequestInput `json:"inputs"`
+}
+
+// BatchReadByUniqueProperty retrieves items of a specific resource by values of a unique property,
+// such as contacts by email or companies by domain.
+// The values are sent in chunks of 100 items. Values that don't match any item are skipped,
+// and the returned results are ordered by the values they are matched with.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BatchReadByUniqueProperty(
+	ctx context.Context,
+	resource, idProperty string,
+	values, properties []string,
+) ([]ListResponseResult, error) {
+	resourcePath, ok := ResourcesBatchReadPaths[resource]
+	if !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	// the idProperty must be requested in order to match results with the values.
+	if !slices.Contains(properties, idProperty) {
+		properties = append(slices.Clone(properties), idProperty)
+	}
+
+	resultsByValue := make(map[string]ListResponseResult, len(values))
+	for chunk := range slices.Chunk(values, batchReadLimit) {
+		batchReadReq := &BatchReadRequest{
+			IDProperty: idProperty,
+			Inputs:     make([]BatchReadRequestInput, len(chunk)),
+			Properties: properties,
+		}
+
+		for i, value := range chunk {
+			batchReadReq.Inputs[i] = BatchReadRequestInput{ID: value}
+		}
+
+		req, err := c.newRequest(ctx, http.MethodPost, resourcePath, batchReadReq)
+		if err != nil {
+			return nil, fmt.Errorf("create new request: %w", err)
+		}
+
+		// the HubSpot API returns 207 if some of the inputs are not found.
+		var resp BatchResponse
+		if err := c.do(req, &resp, []int{http.StatusOK, http.StatusMultiStatus}); err != nil {
+			return nil, fmt.Errorf("execute request: %w", err)
+		}
+
+		for _, result := range resp.Results {
+			if value, ok := result.Property(idProperty); ok {
+				resultsByValue[value] = result
+			}
+		}
+	}
+
+	results := make([]ListResponseResult, 0, len(resultsByValue))
+	for _, value := range values {
+		if result, ok := resultsByValue[value]; ok {
+			results = append(results, result)
+			// delete the result so that duplicated values don't produce duplicated results.
+			delete(resultsByValue, value)
+		}
+	}
+
+	return results, nil
+}
+
+// BatchUpsert creates or updates items of a specific resource matching them by values of a unique property.
+// If an item contains the "properties" field, its value is used as the item's properties,
+// otherwise the whole item is considered as properties. Each item must contain the idProperty.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BatchUpsert(
+	ctx context.Context,
+	resource, idProperty string,
+	items []map[string]any,
+) ([]ListResponseResult, error) {
+	resourcePath, ok := ResourcesBatchUpsertPaths[resource]
+	if !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	batchUpsertReq := &BatchUpsertRequest{
+		Inputs: make([]BatchUpsertRequestInput, len(items)),
+	}
+
+	for i, item := range items {
+		properties := itemProperties(item)
+
+		id, ok := properties[idProperty]
+		if !ok {
+			return nil, &FieldNotExistError{
+				FieldName: idProperty,
+			}
+		}
+
+		batchUpsertReq.Inputs[i] = BatchUpsertRequestInput{
+			ID:         fmt.Sprint(id),
+			IDProperty: idProperty,
+			Properties: properties,
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, batchUpsertReq)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	// the HubSpot API returns 207 if some of the items are created and others are updated.
+	var resp BatchResponse
+	if err := c.do(req, &resp, []int{http.StatusOK, http.StatusMultiStatus}); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// BatchCreate creates items of a specific resource at once.
+// If an item contains the "properties" field, its value is used as the item's properties,
+// otherwise the whole item is considered as properties.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BatchCreate(ctx context.Context, resource string, items []map[string]any) ([]ListResponseResult, error) {
+	resourcePath, ok := ResourcesBatchCreatePaths[resource]
+	if !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	batchCreateReq := &BatchCreateRequest{
+		Inputs: make([]BatchCreateRequestInput, len(items)),
+	}
+
+	for i, item := range items {
+		batchCreateReq.Inputs[i] = BatchCreateRequestInput{
+			Properties: itemProperties(item),
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, batchCreateReq)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp BatchResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// BatchUpdate updates existing items of a specific resource at once.
+// If an input's properties contain the "properties" field, its value is used as the item's properties.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BatchUpdate(
+	ctx context.Context,
+	resource string,
+	inputs []BatchUpdateRequestInput,
+) ([]ListResponseResult, error) {
+	resourcePath, ok := ResourcesBatchUpdatePaths[resource]
+	if !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	batchUpdateReq := &BatchUpdateRequest{
+		Inputs: make([]BatchUpdateRequestInput, len(inputs)),
+	}
+
+	for i, input := range inputs {
+		batchUpdateReq.Inputs[i] = BatchUpdateRequestInput{
+			ID:         input.ID,
+			Properties: itemProperties(input.Properties),
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, batchUpdateReq)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp BatchResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// BatchResult holds the outcome of a write that is split into multiple batch requests.
+type BatchResult struct {
+	// Succeeded holds the ids of the items that are written successfully.
+	Succeeded []string
+	// Failed holds the errors of the batch requests that failed.
+	Failed []BatchError
+}
+
+// UpdateManyOption is a functional option that configures the [Client.UpdateMany].
+type UpdateManyOption func(*updateManyOptions)
+
+// updateManyOptions holds the options of the [Client.UpdateMany].
+type updateManyOptions struct {
+	maxConcurrency int
+}
+
+// WithMaxConcurrency sets the maximum number of batch update requests the [Client.UpdateMany] sends concurrently.
+// Values less than one are ignored.
+func WithMaxConcurrency(n int) UpdateManyOption {
+	return func(o *updateManyOptions) {
+		if n > 0 {
+			o.maxConcurrency = n
+		}
+	}
+}
+
+// UpdateMany updates any number of existing items of a specific resource.
+// The inputs are split into chunks of 100 items that are updated concurrently via the [Client.BatchUpdate].
+// A failed chunk doesn't stop the others, its error is reported in the [BatchResult.Failed] instead.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) UpdateMany(
+	ctx context.Context,
+	resource string,
+	inputs []BatchUpdateRequestInput,
+	opts ...UpdateManyOption,
+) (BatchResult, error) {
+	if _, ok := ResourcesBatchUpdatePaths[resource]; !ok {
+		return BatchResult{}, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	options := updateManyOptions{
+		maxConcurrency: defaultUpdateManyMaxConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var (
+		mu     sync.Mutex
+		result BatchResult
+		group  errgroup.Group
+	)
+
+	group.SetLimit(options.maxConcurrency)
+
+	for chunk := range slices.Chunk(inputs, batchWriteLimit) {
+		itemIDs := make([]string, len(chunk))
+		for i, input := range chunk {
+			itemIDs[i] = input.ID
+		}
+
+		group.Go(func() error {
+			_, err := c.BatchUpdate(ctx, resource, chunk)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				result.Failed = append(result.Failed, BatchError{IDs: itemIDs, Err: err})
+
+				// the error is reported in the result, so the other chunks keep running.
+				return nil
+			}
+
+			result.Succeeded = append(result.Succeeded, itemIDs...)
+
+			return nil
+		})
+	}
+
+	// the goroutines never return errors.
+	_ = group.Wait()
+
+	return result, nil
+}
+
+// BulkResult holds the outcome of the [Client.BulkCreate].
+type BulkResult struct {
+	// Created is the number of the items that are created successfully.
+	Created int
+	// Failed is the number of the items which batch requests failed or were never sent.
+	Failed int
+	// Errors holds the errors of the failed batch requests.
+	Errors []error
+}
+
+// BulkCreate creates any number of items of a specific resource.
+// The items are split into chunks of 100 items, and up to maxConcurrency chunks
+// are created concurrently via the [Client.BatchCreate]. If the maxConcurrency is less than one,
+// the default of 4 is used. A failed chunk doesn't stop the others, its error is reported
+// in the [BulkResult.Errors] instead. If the context is canceled, the in-flight requests are aborted,
+// the remaining chunks are counted as failed, and the method returns the result along with the context's error.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BulkCreate(
+	ctx context.Context,
+	resource string,
+	items []map[string]any,
+	maxConcurrency int,
+) (*BulkResult, error) {
+	if _, ok := ResourcesBatchCreatePaths[resource]; !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	if maxConcurrency < 1 {
+		maxConcurrency = defaultBulkCreateMaxConcurrency
+	}
+
+	var (
+		mu     sync.Mutex
+		result BulkResult
+		group  errgroup.Group
+	)
+
+	group.SetLimit(maxConcurrency)
+
+	for offset := 0; offset < len(items); offset += batchWriteLimit {
+		chunk := items[offset:min(offset+batchWriteLimit, len(items))]
+
+		// the context is checked before each chunk, since the group blocks until a slot is free.
+		if ctx.Err() != nil {
+			mu.Lock()
+			result.Failed += len(items) - offset
+			mu.Unlock()
+
+			break
+		}
+
+		group.Go(func() error {
+			_, err := c.BatchCreate(ctx, resource, chunk)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				result.Failed += len(chunk)
+				result.Errors = append(result.Errors, fmt.Errorf("create items %d-%d: %w", offset, offset+len(chunk)-1, err))
+
+				// the error is reported in the result, so the other chunks keep running.
+				return nil
+			}
+
+			result.Created += len(chunk)
+
+			return nil
+		})
+	}
+
+	// the goroutines never return errors.
+	_ = group.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return &result, fmt.Errorf("bulk create %q items: %w", resource, err)
+	}
+
+	return &result, nil
+}
+
+// BatchDelete archives existing items of a specific resource by their ids at once.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) BatchDelete(ctx context.Context, resource string, itemIDs []string) error {
+	resourcePath, ok := ResourcesBatchArchivePaths[resource]
+	if !ok {
+		return &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	batchArchiveReq := &BatchArchiveRequest{
+		Inputs: make([]BatchReadRequestInput, len(itemIDs)),
+	}
+
+	for i, itemID := range itemIDs {
+		batchArchiveReq.Inputs[i] = BatchReadRequestInput{ID: itemID}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, batchArchiveReq)
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
+
+// itemProperties returns a value of the item's "properties" field if it exists,
+// otherwise the whole item is considered as properties.
+func itemProperties(item map[string]any) map[string]any {
+	if properties, ok := item[propertiesFieldName].(map[string]any); ok {
+		return properties
+	}
+
+	return item
+}
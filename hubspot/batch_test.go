@@ -0,0 +1,765 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_BatchReadByUniqueProperty_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/read", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := map[string]any{
+			"idProperty": "email",
+			"inputs":     []any{map[string]any{"id": "bob@example.com"}, map[string]any{"id": "alice@example.com"}},
+			"properties": []any{"firstname", "email"},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, expected %v", got, want)
+		}
+
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[`+
+			`{"id":"2","properties":{"email":"alice@example.com","firstname":"Alice"}},`+
+			`{"id":"1","properties":{"email":"bob@example.com","firstname":"Bob"}}]}`)
+	})
+
+	got, err := client.BatchReadByUniqueProperty(
+		context.Background(), "crm.contacts", "email",
+		[]string{"bob@example.com", "alice@example.com"}, []string{"firstname"},
+	)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{
+		{"id": "1", "properties": map[string]any{"email": "bob@example.com", "firstname": "Bob"}},
+		{"id": "2", "properties": map[string]any{"email": "alice@example.com", "firstname": "Alice"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_BatchReadByUniqueProperty_chunks(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	var (
+		mu         sync.Mutex
+		chunkSizes []int
+	)
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/read", func(w http.ResponseWriter, r *http.Request) {
+		var req BatchReadRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		mu.Lock()
+		chunkSizes = append(chunkSizes, len(req.Inputs))
+		mu.Unlock()
+
+		resp := BatchResponse{Status: "COMPLETE"}
+		for _, input := range req.Inputs {
+			resp.Results = append(resp.Results, ListResponseResult{
+				"id":         input.ID,
+				"properties": map[string]any{"email": input.ID},
+			})
+		}
+
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("encode response body: %v", err)
+		}
+	})
+
+	values := make([]string, 250)
+	for i := range values {
+		values[i] = strconv.Itoa(i)
+	}
+
+	got, err := client.BatchReadByUniqueProperty(context.Background(), "crm.contacts", "email", values, nil)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if !reflect.DeepEqual(chunkSizes, []int{100, 100, 50}) {
+		t.Errorf("expected chunk sizes to be %v, but got %v", []int{100, 100, 50}, chunkSizes)
+	}
+
+	if len(got) != len(values) {
+		t.Errorf("expected %d results, but got %d", len(values), len(got))
+	}
+}
+
+func TestClient_BatchReadByUniqueProperty_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/read", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[`+
+			`{"id":"1","properties":{"email":"bob@example.com"}}],`+
+			`"numErrors":1,"errors":[{"status":"error","category":"OBJECT_NOT_FOUND",`+
+			`"message":"Could not get some CONTACT objects, they may be deleted or not exist.",`+
+			`"context":{"ids":["alice@example.com"]}}]}`)
+	})
+
+	got, err := client.BatchReadByUniqueProperty(
+		context.Background(), "crm.contacts", "email",
+		[]string{"bob@example.com", "alice@example.com"}, nil,
+	)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{
+		{"id": "1", "properties": map[string]any{"email": "bob@example.com"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_BatchReadByUniqueProperty_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.BatchReadByUniqueProperty(context.Background(), "wrong", "email", []string{"1"}, nil)
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+
+	var unsupportedResourceEerr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceEerr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_BatchUpsert_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/upsert", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := map[string]any{
+			"inputs": []any{
+				map[string]any{
+					"id":         "bob@example.com",
+					"idProperty": "email",
+					"properties": map[string]any{"email": "bob@example.com", "firstname": "Bob"},
+				},
+				map[string]any{
+					"id":         "alice@example.com",
+					"idProperty": "email",
+					"properties": map[string]any{"email": "alice@example.com"},
+				},
+			},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, expected %v", got, want)
+		}
+
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[`+
+			`{"id":"1","properties":{"email":"bob@example.com","firstname":"Bob"}},`+
+			`{"id":"2","properties":{"email":"alice@example.com"}}]}`)
+	})
+
+	got, err := client.BatchUpsert(context.Background(), "crm.contacts", "email", []map[string]any{
+		{"properties": map[string]any{"email": "bob@example.com", "firstname": "Bob"}},
+		{"email": "alice@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{
+		{"id": "1", "properties": map[string]any{"email": "bob@example.com", "firstname": "Bob"}},
+		{"id": "2", "properties": map[string]any{"email": "alice@example.com"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_BatchUpsert_partialSuccess(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/upsert", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[`+
+			`{"id":"1","new":true,"properties":{"email":"bob@example.com"}},`+
+			`{"id":"2","new":false,"properties":{"email":"alice@example.com"}}]}`)
+	})
+
+	got, err := client.BatchUpsert(context.Background(), "crm.contacts", "email", []map[string]any{
+		{"email": "bob@example.com"},
+		{"email": "alice@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{
+		{"id": "1", "new": true, "properties": map[string]any{"email": "bob@example.com"}},
+		{"id": "2", "new": false, "properties": map[string]any{"email": "alice@example.com"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_BatchUpsert_missingIDProperty(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.BatchUpsert(context.Background(), "crm.contacts", "email", []map[string]any{
+		{"firstname": "Bob"},
+	})
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+
+	var fieldNotExistErr *FieldNotExistError
+	if !errors.As(err, &fieldNotExistErr) {
+		t.Errorf("expected error to be FieldNotExistError, but got %v", err)
+	}
+}
+
+func TestClient_BatchCreate_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/create", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := map[string]any{
+			"inputs": []any{
+				map[string]any{"properties": map[string]any{"email": "bob@example.com"}},
+				map[string]any{"properties": map[string]any{"email": "alice@example.com"}},
+			},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, expected %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[{"id":"1"},{"id":"2"}]}`)
+	})
+
+	got, err := client.BatchCreate(context.Background(), "crm.contacts", []map[string]any{
+		{"properties": map[string]any{"email": "bob@example.com"}},
+		{"email": "alice@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{{"id": "1"}, {"id": "2"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_BatchCreate_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.BatchCreate(context.Background(), "cms.blogs.posts", nil)
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_BatchUpdate_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/update", func(w http.ResponseWriter, r *http.Request) {
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := map[string]any{
+			"inputs": []any{
+				map[string]any{"id": "1", "properties": map[string]any{"firstname": "Bob"}},
+				map[string]any{"id": "2", "properties": map[string]any{"firstname": "Alice"}},
+			},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, expected %v", got, want)
+		}
+
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[{"id":"1"},{"id":"2"}]}`)
+	})
+
+	_, err := client.BatchUpdate(context.Background(), "crm.contacts", []BatchUpdateRequestInput{
+		{ID: "1", Properties: map[string]any{"properties": map[string]any{"firstname": "Bob"}}},
+		{ID: "2", Properties: map[string]any{"firstname": "Alice"}},
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_BatchUpdate_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/update", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[{"id":"1"}],"numErrors":1}`)
+	})
+
+	_, err := client.BatchUpdate(context.Background(), "crm.contacts", []BatchUpdateRequestInput{
+		{ID: "1", Properties: map[string]any{"firstname": "Bob"}},
+		{ID: "2", Properties: map[string]any{"firstname": "Alice"}},
+	})
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
+
+func TestClient_BatchDelete_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/archive", func(w http.ResponseWriter, r *http.Request) {
+		var got map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := map[string]any{
+			"inputs": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request body = %v, expected %v", got, want)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.BatchDelete(context.Background(), "crm.contacts", []string{"1", "2"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+// updateManyInputs returns the given number of update inputs with sequential ids starting from 1.
+func updateManyInputs(n int) []BatchUpdateRequestInput {
+	inputs := make([]BatchUpdateRequestInput, n)
+	for i := range inputs {
+		inputs[i] = BatchUpdateRequestInput{
+			ID:         strconv.Itoa(i + 1),
+			Properties: map[string]any{"firstname": "Bob"},
+		}
+	}
+
+	return inputs
+}
+
+func TestClient_UpdateMany_chunks(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	var (
+		mu         sync.Mutex
+		chunkSizes []int
+	)
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/update", func(w http.ResponseWriter, r *http.Request) {
+		var req BatchUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		mu.Lock()
+		chunkSizes = append(chunkSizes, len(req.Inputs))
+		mu.Unlock()
+
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[]}`)
+	})
+
+	result, err := client.UpdateMany(context.Background(), "crm.contacts", updateManyInputs(250), WithMaxConcurrency(2))
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	slices.Sort(chunkSizes)
+	if want := []int{50, 100, 100}; !reflect.DeepEqual(chunkSizes, want) {
+		t.Errorf("chunk sizes = %v, expected %v", chunkSizes, want)
+	}
+
+	if len(result.Succeeded) != 250 {
+		t.Errorf("expected 250 succeeded ids, but got %d", len(result.Succeeded))
+	}
+
+	if len(result.Failed) != 0 {
+		t.Errorf("expected no failed chunks, but got %v", result.Failed)
+	}
+}
+
+func TestClient_UpdateMany_failedChunk(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/update", func(w http.ResponseWriter, r *http.Request) {
+		var req BatchUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		// the first chunk fails, the others succeed.
+		if req.Inputs[0].ID == "1" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status":"error","message":"invalid input"}`)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[]}`)
+	})
+
+	result, err := client.UpdateMany(context.Background(), "crm.contacts", updateManyInputs(250), WithMaxConcurrency(1))
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if len(result.Failed) != 1 {
+		t.Fatalf("expected 1 failed chunk, but got %d", len(result.Failed))
+	}
+
+	if len(result.Failed[0].IDs) != 100 || result.Failed[0].IDs[0] != "1" {
+		t.Errorf("expected the first chunk to fail, but got ids %v", result.Failed[0].IDs)
+	}
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(result.Failed[0].Err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", result.Failed[0].Err)
+	}
+
+	if len(result.Succeeded) != 150 {
+		t.Errorf("expected 150 succeeded ids, but got %d", len(result.Succeeded))
+	}
+}
+
+func TestClient_UpdateMany_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.UpdateMany(context.Background(), "cms.domains", updateManyInputs(1))
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func bulkCreateItems(n int) []map[string]any {
+	items := make([]map[string]any, n)
+	for i := range items {
+		items[i] = map[string]any{"email": fmt.Sprintf("bob%d@example.com", i+1)}
+	}
+
+	return items
+}
+
+func TestClient_BulkCreate_chunks(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	var (
+		mu          sync.Mutex
+		chunkSizes  []int
+		inFlight    int
+		maxInFlight int
+	)
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/create", func(w http.ResponseWriter, r *http.Request) {
+		var req BatchCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		mu.Lock()
+		chunkSizes = append(chunkSizes, len(req.Inputs))
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		// the request is held for a while, so that the concurrent ones overlap.
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[]}`)
+	})
+
+	result, err := client.BulkCreate(context.Background(), "crm.contacts", bulkCreateItems(250), 3)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	slices.Sort(chunkSizes)
+	if want := []int{50, 100, 100}; !reflect.DeepEqual(chunkSizes, want) {
+		t.Errorf("chunk sizes = %v, expected %v", chunkSizes, want)
+	}
+
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 concurrent requests, but got %d", maxInFlight)
+	}
+
+	if want := (&BulkResult{Created: 250}); !reflect.DeepEqual(result, want) {
+		t.Errorf("BulkCreate() = %+v, expected %+v", result, want)
+	}
+}
+
+func TestClient_BulkCreate_failedChunk(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/create", func(w http.ResponseWriter, r *http.Request) {
+		var req BatchCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		// the last chunk fails, the others succeed.
+		if len(req.Inputs) < 100 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status":"error","message":"invalid input"}`)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"COMPLETE","results":[]}`)
+	})
+
+	result, err := client.BulkCreate(context.Background(), "crm.contacts", bulkCreateItems(250), 0)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if result.Created != 200 || result.Failed != 50 {
+		t.Errorf("expected 200 created and 50 failed items, but got %d and %d", result.Created, result.Failed)
+	}
+
+	if len(result.Errors) != 1 {
+		t.Fatalf("expected 1 error, but got %v", result.Errors)
+	}
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(result.Errors[0], &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", result.Errors[0])
+	}
+}
+
+func TestClient_BulkCreate_canceledContext(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux.HandleFunc("/crm/v3/objects/contacts/batch/create", func(_ http.ResponseWriter, r *http.Request) {
+		// the body is read, so that the server notices when the client aborts the request.
+		if _, err := io.Copy(io.Discard, r.Body); err != nil {
+			t.Errorf("read request body: %v", err)
+		}
+
+		// the context is canceled while the first request is in flight,
+		// which aborts it and prevents the remaining chunks from being sent.
+		cancel()
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+			t.Errorf("expected the request to be aborted")
+		}
+	})
+
+	result, err := client.BulkCreate(ctx, "crm.contacts", bulkCreateItems(250), 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error to be context.Canceled, but got %v", err)
+	}
+
+	if result.Created != 0 || result.Failed != 250 {
+		t.Errorf("expected 0 created and 250 failed items, but got %d and %d", result.Created, result.Failed)
+	}
+}
+
+func TestClient_BulkCreate_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.BulkCreate(context.Background(), "cms.domains", bulkCreateItems(1), 1)
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+)
+
+const (
+	// BlogPostsResource is a name of the CMS blog posts resource.
+	BlogPostsResource = "cms.blogs.posts"
+
+	// blogPostsPath is a path of the endpoint that lists blog posts.
+	// https://developers.hubspot.com/docs/api/cms/blog-post
+	blogPostsPath = "/cms/v3/blogs/posts"
+)
+
+// BlogPostState is a publication state of a blog post.
+type BlogPostState string
+
+const (
+	// BlogPostStateDraft is a state of blog posts that have never been published.
+	BlogPostStateDraft BlogPostState = "DRAFT"
+	// BlogPostStateScheduled is a state of blog posts that are scheduled to be published.
+	BlogPostStateScheduled BlogPostState = "SCHEDULED"
+	// BlogPostStatePublished is a state of published blog posts.
+	BlogPostStatePublished BlogPostState = "PUBLISHED"
+)
+
+// BlogPostStates holds all the supported blog post states.
+var BlogPostStates = []BlogPostState{
+	BlogPostStateDraft,
+	BlogPostStateScheduled,
+	BlogPostStatePublished,
+}
+
+// BlogPostsListOptions holds optional params for the [Client.ListBlogPosts] method.
+type BlogPostsListOptions struct {
+	ListOptions
+	// State filters blog posts by their publication state. If it's empty, posts of all states are returned.
+	State BlogPostState `url:"state,omitempty"`
+}
+
+// ListBlogPosts returns a list of blog posts, optionally filtered by their publication state.
+func (c *Client) ListBlogPosts(ctx context.Context, opts *BlogPostsListOptions) (*ListResponse, error) {
+	return c.getList(ctx, blogPostsPath, opts)
+}
@@ -0,0 +1,103 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_ListBlogPosts_state(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/blogs/posts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("state"); got != "SCHEDULED" {
+			t.Errorf("expected state to be %q, but got %q", "SCHEDULED", got)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit to be %q, but got %q", "10", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"total": 1, "results": [{"id": "1", "name": "Release notes", ` +
+			`"state": "SCHEDULED", "created": "2022-10-28T14:58:27Z", "updated": "2022-10-29T14:58:27Z"}]}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.ListBlogPosts(context.Background(), &BlogPostsListOptions{
+		ListOptions: ListOptions{Limit: 10},
+		State:       BlogPostStateScheduled,
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total: 1,
+		Results: []ListResponseResult{{
+			"id":      "1",
+			"name":    "Release notes",
+			"state":   "SCHEDULED",
+			"created": "2022-10-28T14:58:27Z",
+			"updated": "2022-10-29T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_ListBlogPosts_withoutState(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/blogs/posts", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("state") {
+			t.Errorf("expected state to be omitted, but got %q", r.URL.Query().Get("state"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": []}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	_, err := client.ListBlogPosts(context.Background(), nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// CallsResource is a name of the CRM calls resource.
+	CallsResource = "crm.calls"
+
+	// callTranscriptsPath is a path of the endpoint that returns transcripts associated with a call.
+	// https://developers.hubspot.com/docs/api/crm/calls
+	callTranscriptsPath = "/crm/v3/objects/calls/%s/associations/call-transcripts"
+)
+
+// CallTranscript is a transcript associated with a CRM call.
+type CallTranscript struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// callTranscriptsResponse is a response of the call transcripts endpoint.
+type callTranscriptsResponse struct {
+	Results []CallTranscript `json:"results"`
+}
+
+// GetCallTranscript retrieves the text of the transcript associated with a call.
+// If the call has multiple transcripts, their texts are joined by new lines.
+// If the call has no transcripts, the method returns an empty string.
+func (c *Client) GetCallTranscript(ctx context.Context, callID string) (string, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf(callTranscriptsPath, url.PathEscape(callID)), nil)
+	if err != nil {
+		return "", fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp callTranscriptsResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return "", fmt.Errorf("execute request: %w", err)
+	}
+
+	texts := make([]string, 0, len(resp.Results))
+	for _, transcript := range resp.Results {
+		if transcript.Text != "" {
+			texts = append(texts, transcript.Text)
+		}
+	}
+
+	return strings.Join(texts, "\n"), nil
+}
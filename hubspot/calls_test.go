@@ -0,0 +1,101 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetCallTranscript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response string
+		want     string
+	}{
+		{
+			name:     "success",
+			response: `{"results":[{"id":"11","text":"Hello, this is Bob."}]}`,
+			want:     "Hello, this is Bob.",
+		},
+		{
+			name: "success_multiple_transcripts",
+			response: `{"results":[{"id":"11","text":"Hello, this is Bob."},{"id":"12","text":""},` +
+				`{"id":"13","text":"Hi Bob, it's Alice."}]}`,
+			want: "Hello, this is Bob.\nHi Bob, it's Alice.",
+		},
+		{
+			name:     "success_no_transcripts",
+			response: `{"results":[]}`,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc("/crm/v3/objects/calls/42/associations/call-transcripts", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+				}
+
+				fmt.Fprint(w, tt.response)
+			})
+
+			got, err := client.GetCallTranscript(context.Background(), "42")
+			if err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("GetCallTranscript() = %q, expected %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetCallTranscript_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/calls/42/associations/call-transcripts", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status":"error","message":"resource not found"}`)
+	})
+
+	_, err := client.GetCallTranscript(context.Background(), "42")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected error to be ErrNotFound, but got %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitBreakerState is a state of the [Client]'s circuit breaker.
+type CircuitBreakerState int
+
+const (
+	// CircuitClosed is a state in which requests are sent as usual.
+	CircuitClosed CircuitBreakerState = iota
+	// CircuitOpen is a state in which requests fail with the [ErrCircuitOpen] without being sent.
+	CircuitOpen
+	// CircuitHalfOpen is a state in which a single probe request is sent to check whether the HubSpot API recovered.
+	CircuitHalfOpen
+)
+
+// String returns a name of the circuit breaker state.
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker stops sending requests to the HubSpot API for a while
+// after a number of consecutive requests have failed.
+type circuitBreaker struct {
+	mu sync.Mutex
+	// threshold is the number of consecutive failures after which the circuit opens.
+	threshold int
+	// timeout is the duration the circuit stays open before a probe request is allowed.
+	timeout time.Duration
+	state   CircuitBreakerState
+	// failures is the number of consecutive failures in the closed state.
+	failures int
+	// openedAt is the time the circuit was opened at.
+	openedAt time.Time
+	// probing determines whether the probe request of the half-open state is in flight.
+	probing bool
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+}
+
+// WithCircuitBreaker sets a circuit breaker that opens after the threshold consecutive failed requests,
+// so that requests fail with the [ErrCircuitOpen] for the timeout without being sent.
+// After the timeout, a single probe request is sent, which closes the circuit if it succeeds
+// and opens it again otherwise.
+// All failed requests are counted except the rate limited ones, which are neither counted nor reset the count,
+// and the count is reset only by a successful request.
+func WithCircuitBreaker(threshold int, timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.circuitBreaker = &circuitBreaker{
+			threshold: threshold,
+			timeout:   timeout,
+			now:       time.Now,
+		}
+	}
+}
+
+// CircuitState returns the current state of the Client's circuit breaker.
+// If the Client has no circuit breaker, the state is always the [CircuitClosed].
+// The open circuit becomes half-open on the first request after its timeout.
+func (c *Client) CircuitState() CircuitBreakerState {
+	if c.circuitBreaker == nil {
+		return CircuitClosed
+	}
+
+	c.circuitBreaker.mu.Lock()
+	defer c.circuitBreaker.mu.Unlock()
+
+	return c.circuitBreaker.state
+}
+
+// allow checks whether a request can be sent, and returns the [ErrCircuitOpen] if it cannot.
+// A nil circuit breaker allows all requests.
+func (cb *circuitBreaker) allow() error {
+	if cb == nil {
+		return nil
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitClosed:
+		return nil
+
+	case CircuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.timeout {
+			return ErrCircuitOpen
+		}
+
+		cb.state = CircuitHalfOpen
+	}
+
+	// only one probe request is sent in the half-open state.
+	if cb.probing {
+		return ErrCircuitOpen
+	}
+
+	cb.probing = true
+
+	return nil
+}
+
+// record records the result of a sent request with the provided status code,
+// which is zero if the request has failed to send, and the error the request has failed with.
+func (cb *circuitBreaker) record(req *http.Request, statusCode int, err error) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+
+	// a canceled request says nothing about the HubSpot API availability.
+	if statusCode == 0 && req.Context().Err() != nil {
+		return
+	}
+
+	if err == nil {
+		cb.state = CircuitClosed
+		cb.failures = 0
+
+		return
+	}
+
+	// a rate limited request is retried later, and the HubSpot API is available.
+	if statusCode == http.StatusTooManyRequests {
+		return
+	}
+
+	if cb.state == CircuitHalfOpen {
+		cb.open()
+
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.open()
+	}
+}
+
+// open opens the circuit, the caller must hold the mutex.
+func (cb *circuitBreaker) open() {
+	cb.state = CircuitOpen
+	cb.openedAt = cb.now()
+	cb.failures = 0
+}
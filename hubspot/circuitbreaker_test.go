@@ -0,0 +1,249 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupCircuitBreaker returns a Client with a circuit breaker which server responds with the status code
+// stored in the returned value, the number of received requests, and a function that advances the breaker's clock.
+func setupCircuitBreaker(t *testing.T, threshold int) (*Client, *atomic.Int32, *atomic.Int32, func(time.Duration)) {
+	t.Helper()
+
+	client, mux, teardown := setup()
+	t.Cleanup(teardown)
+
+	WithCircuitBreaker(threshold, time.Minute)(client)
+
+	now := time.Now()
+	client.circuitBreaker.now = func() time.Time {
+		return now
+	}
+
+	statusCode, requests := &atomic.Int32{}, &atomic.Int32{}
+	statusCode.Store(http.StatusOK)
+
+	mux.HandleFunc("/crm/v3/objects/contacts", func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(int(statusCode.Load()))
+	})
+
+	return client, statusCode, requests, func(d time.Duration) {
+		now = now.Add(d)
+	}
+}
+
+func TestClient_CircuitState_transitions(t *testing.T) {
+	t.Parallel()
+
+	client, statusCode, requests, advance := setupCircuitBreaker(t, 2)
+
+	list := func() error {
+		_, err := client.List(context.Background(), "crm.contacts", nil)
+
+		return err
+	}
+
+	statusCode.Store(http.StatusServiceUnavailable)
+
+	for range 2 {
+		if err := list(); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected error to be an unexpected status code error, but got %v", err)
+		}
+	}
+
+	if got := client.CircuitState(); got != CircuitOpen {
+		t.Fatalf("expected circuit state to be %s, but got %s", CircuitOpen, got)
+	}
+
+	// the open circuit fails requests without sending them.
+	if err := list(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected error to be ErrCircuitOpen, but got %v", err)
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Fatalf("expected %d requests to be sent, but got %d", 2, got)
+	}
+
+	// the failed probe opens the circuit again.
+	advance(time.Minute)
+
+	if err := list(); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected error to be an unexpected status code error, but got %v", err)
+	}
+
+	if got := client.CircuitState(); got != CircuitOpen {
+		t.Fatalf("expected circuit state to be %s, but got %s", CircuitOpen, got)
+	}
+
+	if err := list(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected error to be ErrCircuitOpen, but got %v", err)
+	}
+
+	// the successful probe closes the circuit.
+	advance(time.Minute)
+	statusCode.Store(http.StatusOK)
+
+	if err := list(); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if got := client.CircuitState(); got != CircuitClosed {
+		t.Fatalf("expected circuit state to be %s, but got %s", CircuitClosed, got)
+	}
+
+	if got := requests.Load(); got != 4 {
+		t.Errorf("expected %d requests to be sent, but got %d", 4, got)
+	}
+}
+
+func TestClient_CircuitState_countedErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       CircuitBreakerState
+	}{
+		{
+			name:       "service_unavailable",
+			statusCode: http.StatusServiceUnavailable,
+			want:       CircuitOpen,
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			want:       CircuitOpen,
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			want:       CircuitOpen,
+		},
+		{
+			name:       "not_found",
+			statusCode: http.StatusNotFound,
+			want:       CircuitOpen,
+		},
+		{
+			name:       "too_many_requests",
+			statusCode: http.StatusTooManyRequests,
+			want:       CircuitClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, statusCode, _, _ := setupCircuitBreaker(t, 2)
+
+			statusCode.Store(int32(tt.statusCode))
+
+			for range 2 {
+				if _, err := client.List(context.Background(), "crm.contacts", nil); err == nil {
+					t.Fatalf("expected error to be returned")
+				}
+			}
+
+			if got := client.CircuitState(); got != tt.want {
+				t.Errorf("expected circuit state to be %s, but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_CircuitState_resetCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       CircuitBreakerState
+	}{
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			want:       CircuitClosed,
+		},
+		{
+			name:       "too_many_requests",
+			statusCode: http.StatusTooManyRequests,
+			want:       CircuitOpen,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, statusCode, _, _ := setupCircuitBreaker(t, 2)
+
+			// only the successful response resets the count of the preceding failure.
+			for _, code := range []int{http.StatusInternalServerError, tt.statusCode, http.StatusInternalServerError} {
+				statusCode.Store(int32(code))
+
+				_, _ = client.List(context.Background(), "crm.contacts", nil)
+			}
+
+			if got := client.CircuitState(); got != tt.want {
+				t.Errorf("expected circuit state to be %s, but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_CircuitState_halfOpen(t *testing.T) {
+	t.Parallel()
+
+	client, _, _, advance := setupCircuitBreaker(t, 1)
+
+	client.circuitBreaker.record(&http.Request{}, http.StatusBadGateway,
+		&UnexpectedStatusCodeError{StatusCode: http.StatusBadGateway})
+	advance(time.Minute)
+
+	// only one probe request is allowed until its result is recorded.
+	if err := client.circuitBreaker.allow(); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if got := client.CircuitState(); got != CircuitHalfOpen {
+		t.Fatalf("expected circuit state to be %s, but got %s", CircuitHalfOpen, got)
+	}
+
+	if err := client.circuitBreaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected error to be ErrCircuitOpen, but got %v", err)
+	}
+}
+
+func TestClient_CircuitState_disabled(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient("secret", http.DefaultClient)
+
+	if got := client.CircuitState(); got != CircuitClosed {
+		t.Errorf("expected circuit state to be %s, but got %s", CircuitClosed, got)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -destination=mock/hubspot_client.go -package=mock . ClientInterface
+
+package hubspot
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ClientInterface defines the [Client] methods used by the source iterators and the destination writer,
+// so that they can be unit-tested with a mock instead of a test server.
+type ClientInterface interface {
+	List(ctx context.Context, resource string, opts *ListOptions) (*ListResponse, error)
+	ListByNextLink(ctx context.Context, nextLink string) (*ListResponse, error)
+	ListBlogPosts(ctx context.Context, opts *BlogPostsListOptions) (*ListResponse, error)
+	ListSitePages(ctx context.Context, opts *SitePagesListOptions) (*ListResponse, error)
+	SearchURLRedirects(ctx context.Context, query string, opts *ListOptions) (*ListResponse, error)
+	GetConversationMessages(ctx context.Context, threadID string, opts *ListOptions) (*ListResponse, error)
+	Search(ctx context.Context, resource string, request *SearchRequest) (*ListResponse, error)
+	SearchByUpdatedAfter(
+		ctx context.Context,
+		resource string,
+		updatedAfter time.Time,
+		limit int,
+		properties []string,
+	) (*ListResponse, error)
+	SearchByCreatedBefore(
+		ctx context.Context,
+		resource string,
+		createdBefore time.Time,
+		limit int,
+		after string,
+		properties []string,
+		orderBy string,
+	) (*ListResponse, error)
+	SearchByMultipleFields(
+		ctx context.Context,
+		resource string,
+		filters []SearchRequestFilterGroupFilter,
+		sorts []SearchRequestSort,
+		limit int,
+		opts ...SearchOption,
+	) (*ListResponse, error)
+	Create(ctx context.Context, resource string, item map[string]any) (string, error)
+	Update(ctx context.Context, resource, itemID string, item map[string]any) error
+	Delete(ctx context.Context, resource, itemID string) error
+	BatchUpsert(ctx context.Context, resource, idProperty string, items []map[string]any) ([]ListResponseResult, error)
+	BatchCreate(ctx context.Context, resource string, items []map[string]any) ([]ListResponseResult, error)
+	BatchUpdate(ctx context.Context, resource string, inputs []BatchUpdateRequestInput) ([]ListResponseResult, error)
+	BulkCreate(ctx context.Context, resource string, items []map[string]any, maxConcurrency int) (*BulkResult, error)
+	UpdateMany(
+		ctx context.Context, resource string, inputs []BatchUpdateRequestInput, opts ...UpdateManyOption,
+	) (BatchResult, error)
+	BatchDelete(ctx context.Context, resource string, itemIDs []string) error
+	PublishPage(ctx context.Context, resource, pageID string) error
+	GetContactByEmail(ctx context.Context, email string, properties []string) (ListResponseResult, error)
+	GetCallTranscript(ctx context.Context, callID string) (string, error)
+	Associate(ctx context.Context, resource, itemID, toObjectType, toObjectID, associationType string) error
+	BulkExport(ctx context.Context, resource string, properties []string) (string, error)
+	PollExportStatus(ctx context.Context, exportID string) (*ExportStatus, error)
+	DownloadExport(ctx context.Context, downloadURL string) (io.ReadCloser, error)
+}
@@ -0,0 +1,154 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// ContactsResource is a name of the contacts resource.
+	ContactsResource = "crm.contacts"
+	// ContactEmailProperty is a name of the contact's email property.
+	ContactEmailProperty = "email"
+	// ResultsFieldAssociations defines a field key for item associations.
+	ResultsFieldAssociations = "associations"
+
+	// contactPath is a path of the endpoint that retrieves a single contact.
+	contactPath = "/crm/v3/objects/contacts/{objectId}"
+)
+
+// Association is an object associated with an item, such as a company associated with a contact.
+type Association struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// associationsResponse is a response model for a list of associated objects of a single type.
+type associationsResponse struct {
+	Results []Association `json:"results"`
+}
+
+// getContactOptions holds query params for the [GetContactByID] method.
+type getContactOptions struct {
+	Properties   []string `url:"properties,comma,omitempty"`
+	Associations []string `url:"associations,comma,omitempty"`
+}
+
+// GetContactByID returns a single contact with a specific id, including the provided properties.
+// If associations are provided, for example companies and deals, the ids of the associated objects
+// are returned under the associations key as a map[string][]Association keyed by the object type.
+func (c *Client) GetContactByID(
+	ctx context.Context,
+	id string,
+	properties []string,
+	associations []string,
+) (ListResponseResult, error) {
+	resourcePath, err := addOptions(
+		strings.ReplaceAll(contactPath, objectIDPlaceholder, url.PathEscape(id)),
+		&getContactOptions{
+			Properties:   properties,
+			Associations: associations,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("add options: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := c.do(req, &body, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	var result ListResponseResult
+	if err := json.Unmarshal(body.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("unmarshal contact: %w", err)
+	}
+
+	if _, ok := result[ResultsFieldAssociations]; !ok {
+		return result, nil
+	}
+
+	// HubSpot wraps associated objects of each type into a paged list,
+	// so they are parsed once more to expose just the objects.
+	var resp struct {
+		Associations map[string]associationsResponse `json:"associations"`
+	}
+	if err := json.Unmarshal(body.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal contact associations: %w", err)
+	}
+
+	contactAssociations := make(map[string][]Association, len(resp.Associations))
+	for objectType, associations := range resp.Associations {
+		contactAssociations[objectType] = associations.Results
+	}
+
+	result[ResultsFieldAssociations] = contactAssociations
+
+	return result, nil
+}
+
+// GetContactByEmail is a wrapper that calls the [Search] method returning a single contact with a specific email.
+// The method returns a *[ContactNotFoundError] if there are no contacts with the email,
+// and an *[AmbiguousContactError] if there are multiple ones.
+func (c *Client) GetContactByEmail(ctx context.Context, email string, properties []string) (ListResponseResult, error) {
+	listResponse, err := c.Search(ctx, ContactsResource, &SearchRequest{
+		// we only need to know whether there are more contacts than one,
+		// the total number of them is returned anyway.
+		Limit:      "2",
+		Properties: properties,
+		FilterGroups: []SearchRequestFilterGroup{
+			{
+				Filters: []SearchRequestFilterGroupFilter{
+					{
+						PropertyName: ContactEmailProperty,
+						Operator:     EQOperator,
+						Value:        email,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search contacts: %w", err)
+	}
+
+	switch {
+	case listResponse.Total == 0 || len(listResponse.Results) == 0:
+		return nil, &ContactNotFoundError{
+			Email: email,
+		}
+
+	case listResponse.Total > 1:
+		return nil, &AmbiguousContactError{
+			Email: email,
+			Total: listResponse.Total,
+		}
+
+	default:
+		return listResponse.Results[0], nil
+	}
+}
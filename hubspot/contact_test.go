@@ -0,0 +1,227 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetContactByEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response string
+		want     ListResponseResult
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			response: `{"total": 1, "results": [{"id": "1", "properties": {"email": "bob@example.com"}}]}`,
+			want: ListResponseResult{
+				"id":         "1",
+				"properties": map[string]any{"email": "bob@example.com"},
+			},
+		},
+		{
+			name:     "fail_not_found",
+			response: `{"total": 0, "results": []}`,
+			wantErr:  &ContactNotFoundError{},
+		},
+		{
+			name:     "fail_ambiguous",
+			response: `{"total": 3, "results": [{"id": "1"}, {"id": "2"}]}`,
+			wantErr:  &AmbiguousContactError{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc("/crm/v3/objects/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+				var req SearchRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("decode request body: %v", err)
+				}
+
+				wantFilterGroups := []SearchRequestFilterGroup{{
+					Filters: []SearchRequestFilterGroupFilter{{
+						PropertyName: "email",
+						Operator:     EQOperator,
+						Value:        "bob@example.com",
+					}},
+				}}
+
+				if !reflect.DeepEqual(req.FilterGroups, wantFilterGroups) {
+					t.Errorf("expected filter groups to be %v, but got %v", wantFilterGroups, req.FilterGroups)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(tt.response)); err != nil {
+					t.Errorf("write body: %v", err)
+				}
+			})
+
+			got, err := client.GetContactByEmail(context.Background(), "bob@example.com", nil)
+
+			switch wantErr := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Errorf("expected error to be nil, but got %v", err)
+				}
+
+			case *ContactNotFoundError:
+				if !errors.As(err, &wantErr) {
+					t.Errorf("expected error to be ContactNotFoundError, but got %v", err)
+				}
+
+			case *AmbiguousContactError:
+				if !errors.As(err, &wantErr) {
+					t.Errorf("expected error to be AmbiguousContactError, but got %v", err)
+				}
+
+				if wantErr.Total != 3 {
+					t.Errorf("expected total to be 3, but got %d", wantErr.Total)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetContactByEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetContactByID(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("properties"); got != "email,firstname" {
+			t.Errorf("expected properties to be %q, but got %q", "email,firstname", got)
+		}
+
+		if got := r.URL.Query().Get("associations"); got != "companies,deals" {
+			t.Errorf("expected associations to be %q, but got %q", "companies,deals", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"id": "1", "properties": {"email": "bob@example.com", "firstname": "Bob"}, ` +
+			`"associations": {"companies": {"results": [{"id": "10", "type": "contact_to_company"}]}, ` +
+			`"deals": {"results": [{"id": "20", "type": "contact_to_deal"}, {"id": "21", "type": "contact_to_deal"}]}}}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetContactByID(
+		context.Background(), "1", []string{"email", "firstname"}, []string{"companies", "deals"},
+	)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := ListResponseResult{
+		"id":         "1",
+		"properties": map[string]any{"email": "bob@example.com", "firstname": "Bob"},
+		"associations": map[string][]Association{
+			"companies": {{ID: "10", Type: "contact_to_company"}},
+			"deals":     {{ID: "20", Type: "contact_to_deal"}, {ID: "21", Type: "contact_to_deal"}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetContactByID() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_GetContactByID_withoutAssociations(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected query to be empty, but got %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id": "1", "properties": {"email": "bob@example.com"}}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetContactByID(context.Background(), "1", nil, nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := ListResponseResult{
+		"id":         "1",
+		"properties": map[string]any{"email": "bob@example.com"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetContactByID() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_GetContactByID_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetContactByID(context.Background(), "1", nil, nil)
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
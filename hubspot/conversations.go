@@ -0,0 +1,54 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// ConversationMessagesResource is a name of the conversation thread messages resource.
+	ConversationMessagesResource = "conversations.messages"
+
+	// conversationMessagesPath is a path of the endpoint that lists messages of a conversation thread.
+	// https://developers.hubspot.com/docs/api/conversations/conversations
+	conversationMessagesPath = "/conversations/v3/conversations/threads/{threadId}/messages"
+)
+
+// GetConversationMessages returns a list of messages of a conversation thread with a specific id.
+func (c *Client) GetConversationMessages(ctx context.Context, threadID string, opts *ListOptions) (*ListResponse, error) {
+	resourcePath, err := addOptions(
+		strings.ReplaceAll(conversationMessagesPath, threadIDPlaceholder, url.PathEscape(threadID)), opts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("add options: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp ListResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,104 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetConversationMessages_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/conversations/v3/conversations/threads/42/messages", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit to be %q, but got %q", "10", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": [{"id": "1", "text": "hello", ` +
+			`"createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-28T14:58:27Z"}]}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetConversationMessages(context.Background(), "42", &ListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"id":        "1",
+			"text":      "hello",
+			"createdAt": "2022-10-28T14:58:27Z",
+			"updatedAt": "2022-10-28T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_GetConversationMessages_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/conversations/v3/conversations/threads/42/messages", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetConversationMessages(context.Background(), "42", nil)
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
+
+func TestClient_List_conversationMessages(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.List(context.Background(), ConversationMessagesResource, nil)
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
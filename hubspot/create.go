@@ -20,6 +20,13 @@ import (
 	"net/http"
 )
 
+// ResourcesReadOnly holds a set of resources that HubSpot allows only to read,
+// even though they may share the write endpoints of other CRM objects.
+var ResourcesReadOnly = map[string]bool{
+	// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+	"crm.feedbackSubmissions": true,
+}
+
 // ResourcesCreatePaths holds a mapping of supported resources and their create endpoints.
 var ResourcesCreatePaths = map[string]string{
 	// https://developers.hubspot.com/docs/api/cms/blog-authors
@@ -33,6 +40,7 @@ var ResourcesCreatePaths = map[string]string{
 	"cms.pages.site":    "/cms/v3/pages/site-pages",
 	// https://developers.hubspot.com/docs/api/cms/hubdb
 	"cms.hubdb.tables": "/cms/v3/hubdb/tables",
+	"cms.hubdb.rows":   "/cms/v3/hubdb/tables/{tableId}/rows",
 	// https://developers.hubspot.com/docs/api/cms/url-redirects
 	"cms.urlRedirects": "/cms/v3/url-redirects",
 	// https://developers.hubspot.com/docs/api/crm/companies
@@ -61,27 +69,39 @@ var ResourcesCreatePaths = map[string]string{
 	"crm.notes": "/crm/v3/objects/notes",
 	// https://developers.hubspot.com/docs/api/crm/tasks
 	"crm.tasks": "/crm/v3/objects/tasks",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues",
+	// https://developers.hubspot.com/docs/api/marketing/marketing-email
+	"marketing.emails": "/marketing/v3/emails",
 	// https://developers.hubspot.com/docs/api/marketing/forms
+	"cms.forms": "/marketing/v3/forms",
 }
 
-// Create creates a new item of a specific resource.
+// Create creates a new item of a specific resource and returns the created item's id.
 // The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
-func (c *Client) Create(ctx context.Context, resource string, item map[string]any) error {
+func (c *Client) Create(ctx context.Context, resource string, item map[string]any) (string, error) {
 	resourcePath, ok := ResourcesCreatePaths[resource]
 	if !ok {
-		return &UnsupportedResourceError{
+		return "", &UnsupportedResourceError{
 			Resource: resource,
 		}
 	}
 
-	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, item)
+	req, err := c.newRequest(ctx, http.MethodPost, c.hubDB.resolvePath(resourcePath), item)
 	if err != nil {
-		return fmt.Errorf("create new request: %w", err)
+		return "", fmt.Errorf("create new request: %w", err)
 	}
 
-	if err := c.do(req, nil); err != nil {
-		return fmt.Errorf("execute request: %w", err)
+	var resp ListResponseResult
+	if err := c.do(req, &resp, nil); err != nil {
+		return "", fmt.Errorf("execute request: %w", err)
 	}
 
-	return nil
+	// the id of the created item is a string for all the supported resources,
+	// so we don't need to handle other types here.
+	itemID, _ := resp[ResultsFieldID].(string)
+
+	return itemID, nil
 }
@@ -17,6 +17,7 @@ package hubspot
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -47,12 +48,120 @@ func TestClient_Create_success(t *testing.T) {
 		}
 
 		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"id":"1","properties":{"name":"Bob"}}`)
 	})
 
-	err := client.Create(context.Background(), "crm.quotes", map[string]any{"name": "Bob"})
+	itemID, err := client.Create(context.Background(), "crm.quotes", map[string]any{"name": "Bob"})
 	if err != nil {
 		t.Errorf("expected error to be nil, but got %v", err)
 	}
+
+	if itemID != "1" {
+		t.Errorf("expected item id to be %q, but got %q", "1", itemID)
+	}
+}
+
+func TestClient_Create_successCreated(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/quotes", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"2","properties":{"name":"Bob"}}`)
+	})
+
+	itemID, err := client.Create(context.Background(), "crm.quotes", map[string]any{"name": "Bob"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "2" {
+		t.Errorf("expected item id to be %q, but got %q", "2", itemID)
+	}
+}
+
+func TestClient_Create_successNoContent(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/quotes", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	itemID, err := client.Create(context.Background(), "crm.quotes", map[string]any{"name": "Bob"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "" {
+		t.Errorf("expected item id to be empty, but got %q", itemID)
+	}
+}
+
+func TestClient_Create_marketingEmails(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/emails", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"3","name":"Newsletter"}`)
+	})
+
+	itemID, err := client.Create(context.Background(), "marketing.emails", map[string]any{"name": "Newsletter"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "3" {
+		t.Errorf("expected item id to be %q, but got %q", "3", itemID)
+	}
+}
+
+func TestClient_Create_cmsForms(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/forms", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"4","name":"Contact us"}`)
+	})
+
+	itemID, err := client.Create(context.Background(), "cms.forms", map[string]any{"name": "Contact us"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "4" {
+		t.Errorf("expected item id to be %q, but got %q", "4", itemID)
+	}
 }
 
 func TestClient_Create_unsupportedResource(t *testing.T) {
@@ -64,7 +173,7 @@ func TestClient_Create_unsupportedResource(t *testing.T) {
 		teardown()
 	})
 
-	err := client.Create(context.Background(), "wrong", map[string]any{"name": "Bob"})
+	_, err := client.Create(context.Background(), "wrong", map[string]any{"name": "Bob"})
 	if err == nil {
 		t.Errorf("expected error, but got nil")
 	}
@@ -74,3 +183,95 @@ func TestClient_Create_unsupportedResource(t *testing.T) {
 		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
 	}
 }
+
+func TestClient_Create_hubDBRows(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	client.SetHubDBResource(HubDBResource{TableID: "123"})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/123/rows", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"1","values":{"name":"Bob"}}`)
+	})
+
+	itemID, err := client.Create(
+		context.Background(), "cms.hubdb.rows", map[string]any{"values": map[string]any{"name": "Bob"}},
+	)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "1" {
+		t.Errorf("expected item id to be %q, but got %q", "1", itemID)
+	}
+}
+
+func TestClient_Create_crmPostalMail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/postal_mail", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"5","properties":{"hs_postal_mail_body":"Welcome letter"}}`)
+	})
+
+	itemID, err := client.Create(context.Background(), "crm.postalMail", map[string]any{
+		"properties": map[string]any{"hs_postal_mail_body": "Welcome letter"},
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "5" {
+		t.Errorf("expected item id to be %q, but got %q", "5", itemID)
+	}
+}
+
+func TestClient_Create_crmTaskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"5","properties":{"hs_task_queues_body":"Renewals"}}`)
+	})
+
+	itemID, err := client.Create(context.Background(), "crm.taskQueues", map[string]any{
+		"properties": map[string]any{"hs_task_queues_body": "Renewals"},
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if itemID != "5" {
+		t.Errorf("expected item id to be %q, but got %q", "5", itemID)
+	}
+}
@@ -36,6 +36,10 @@ var ResourcesDeletePaths = map[string]string{
 	"cms.hubdb.tables": "/cms/v3/hubdb/tables/{objectId}/draft",
 	// https://developers.hubspot.com/docs/api/cms/url-redirects
 	"cms.urlRedirects": "/cms/v3/url-redirects/{objectId}",
+	// https://developers.hubspot.com/docs/api/cms/domains
+	"cms.domains": "/cms/v3/domains/{objectId}",
+	// https://developers.hubspot.com/docs/api/marketing/forms
+	"cms.forms": "/marketing/v3/forms/{objectId}",
 	// https://developers.hubspot.com/docs/api/crm/companies
 	"crm.companies": "/crm/v3/objects/companies/{objectId}",
 	// https://developers.hubspot.com/docs/api/crm/contacts
@@ -62,6 +66,12 @@ var ResourcesDeletePaths = map[string]string{
 	"crm.notes": "/crm/v3/objects/notes/{objectId}",
 	// https://developers.hubspot.com/docs/api/crm/tasks
 	"crm.tasks": "/crm/v3/objects/tasks/{objectId}",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail/{objectId}",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues/{objectId}",
+	// https://developers.hubspot.com/docs/api/marketing/marketing-email
+	"marketing.emails": "/marketing/v3/emails/{objectId}",
 }
 
 // Delete tries to dekete an existing item of a specific resource.
@@ -81,7 +91,46 @@ func (c *Client) Delete(ctx context.Context, resource, itemID string) error {
 		return fmt.Errorf("create new request: %w", err)
 	}
 
-	if err := c.do(req, nil); err != nil {
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
+
+// ResourcesArchivePaths holds a mapping of resources whose archive endpoints differ from their delete ones.
+// The other resources are archived using their [ResourcesDeletePaths] endpoints.
+var ResourcesArchivePaths = map[string]string{
+	// the delete endpoint removes only the draft version of a table, whereas this one archives the table itself.
+	// https://developers.hubspot.com/docs/api/cms/hubdb
+	"cms.hubdb.tables": "/cms/v3/hubdb/tables/{objectId}",
+}
+
+// archiveSuccessCodes holds the status codes HubSpot returns when an item is archived.
+// Most resources respond with 204 No Content, but some of them respond with 200 OK.
+var archiveSuccessCodes = []int{http.StatusOK, http.StatusNoContent}
+
+// Archive archives (soft-deletes) an existing item of a specific resource.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) Archive(ctx context.Context, resource, itemID string) error {
+	resourcePath, ok := ResourcesArchivePaths[resource]
+	if !ok {
+		resourcePath, ok = ResourcesDeletePaths[resource]
+		if !ok {
+			return &UnsupportedResourceError{
+				Resource: resource,
+			}
+		}
+	}
+
+	resourcePath = strings.ReplaceAll(resourcePath, objectIDPlaceholder, itemID)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, resourcePath, nil)
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, archiveSuccessCodes); err != nil {
 		return fmt.Errorf("execute request: %w", err)
 	}
 
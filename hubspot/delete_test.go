@@ -44,6 +44,75 @@ func TestClient_Delete_success(t *testing.T) {
 	}
 }
 
+func TestClient_Delete_cmsDomains(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/domains/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Delete(context.Background(), "cms.domains", "1")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Delete_marketingEmails(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/emails/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Delete(context.Background(), "marketing.emails", "1")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Delete_cmsForms(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/forms/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Delete(context.Background(), "cms.forms", "1")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
 func TestClient_Delete_unsupportedResource(t *testing.T) {
 	t.Parallel()
 
@@ -63,3 +132,144 @@ func TestClient_Delete_unsupportedResource(t *testing.T) {
 		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
 	}
 }
+
+func TestClient_Archive_success(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		resource   string
+		path       string
+		statusCode int
+	}{
+		{
+			name:       "no_content",
+			resource:   "crm.contacts",
+			path:       "/crm/v3/objects/contacts/1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "ok",
+			resource:   "crm.contacts",
+			path:       "/crm/v3/objects/contacts/1",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "archive_path",
+			resource:   "cms.hubdb.tables",
+			path:       "/cms/v3/hubdb/tables/1",
+			statusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+				}
+
+				w.WriteHeader(tt.statusCode)
+			})
+
+			err := client.Archive(context.Background(), tt.resource, "1")
+			if err != nil {
+				t.Errorf("expected error to be nil, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_Archive_unexpectedStatusCode(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := client.Archive(context.Background(), "crm.contacts", "1")
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
+
+func TestClient_Archive_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	err := client.Archive(context.Background(), "wrong", "1")
+
+	var unsupportedResourceEerr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceEerr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_Delete_crmPostalMail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/postal_mail/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Delete(context.Background(), "crm.postalMail", "1")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Delete_crmTaskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method to be %s, but got %s", http.MethodDelete, r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Delete(context.Background(), "crm.taskQueues", "1")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// engagementsPath is a path of the legacy Engagements API v1 endpoint that lists all engagements.
+// https://legacydocs.hubspot.com/docs/methods/engagements/get-all-engagements
+const engagementsPath = "/engagements/v1/engagements/paged"
+
+// EngagementsListOptions holds optional params for the [GetEngagements] method.
+type EngagementsListOptions struct {
+	Limit  int `url:"limit,omitempty"`
+	Offset int `url:"offset,omitempty"`
+}
+
+// EngagementsListResponse is a response model of the legacy Engagements API v1.
+// Unlike CRM v3 responses, it's paged by the offset returned with the HasMore flag.
+type EngagementsListResponse struct {
+	Results []EngagementResult `json:"results"`
+	HasMore bool               `json:"hasMore"`
+	Offset  int                `json:"offset"`
+}
+
+// EngagementResult is a result object for the [EngagementsListResponse].
+// The engagement's id, type, and timestamps are kept in the Engagement map.
+type EngagementResult struct {
+	Engagement   map[string]any `json:"engagement"`
+	Associations map[string]any `json:"associations"`
+	Metadata     map[string]any `json:"metadata"`
+}
+
+// GetEngagements retrieves a list of engagements using the legacy Engagements API v1.
+func (c *Client) GetEngagements(ctx context.Context, opts *EngagementsListOptions) (*EngagementsListResponse, error) {
+	resourcePath, err := addOptions(engagementsPath, opts)
+	if err != nil {
+		return nil, fmt.Errorf("add options: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp EngagementsListResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
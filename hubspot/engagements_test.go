@@ -0,0 +1,84 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// testEngagementsResponse is a legacy Engagements API v1 response that contains a single note.
+const testEngagementsResponse = `{"results": [{"engagement": {"id": 29090716, "type": "NOTE", ` +
+	`"createdAt": 1444223400781, "lastUpdated": 1444223400781}, ` +
+	`"associations": {"contactIds": [247], "companyIds": []}, ` +
+	`"metadata": {"body": "note body"}}], "hasMore": true, "offset": 29090716}`
+
+func TestClient_GetEngagements(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/engagements/v1/engagements/paged", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "1" {
+			t.Errorf("expected limit to be %q, but got %q", "1", got)
+		}
+
+		if got := r.URL.Query().Get("offset"); got != "100" {
+			t.Errorf("expected offset to be %q, but got %q", "100", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(testEngagementsResponse)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetEngagements(context.Background(), &EngagementsListOptions{Limit: 1, Offset: 100})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &EngagementsListResponse{
+		Results: []EngagementResult{{
+			Engagement: map[string]any{
+				"id":          float64(29090716),
+				"type":        "NOTE",
+				"createdAt":   float64(1444223400781),
+				"lastUpdated": float64(1444223400781),
+			},
+			Associations: map[string]any{
+				"contactIds": []any{float64(247)},
+				"companyIds": []any{},
+			},
+			Metadata: map[string]any{"body": "note body"},
+		}},
+		HasMore: true,
+		Offset:  29090716,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetEngagements() = %v, want %v", got, want)
+	}
+}
@@ -14,7 +14,27 @@
 
 package hubspot
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrUnauthorized matches an [UnexpectedStatusCodeError] with the 401 status code,
+	// which the HubSpot API returns if the access token is invalid or expired.
+	ErrUnauthorized = &UnexpectedStatusCodeError{StatusCode: http.StatusUnauthorized}
+	// ErrForbidden matches an [UnexpectedStatusCodeError] with the 403 status code,
+	// which the HubSpot API returns if the access token lacks the required scopes.
+	ErrForbidden = &UnexpectedStatusCodeError{StatusCode: http.StatusForbidden}
+	// ErrNotFound matches an [UnexpectedStatusCodeError] with the 404 status code.
+	ErrNotFound = &UnexpectedStatusCodeError{StatusCode: http.StatusNotFound}
+	// ErrTooManyRequests matches an [UnexpectedStatusCodeError] with the 429 status code,
+	// which the HubSpot API returns if the rate limit is exceeded.
+	ErrTooManyRequests = &UnexpectedStatusCodeError{StatusCode: http.StatusTooManyRequests}
+	// ErrCircuitOpen occurs when a request is not sent because the circuit breaker of the [Client] is open.
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+)
 
 // UnexpectedStatusCodeError occurs when a response from the HubSpot API has non-200 status code.
 type UnexpectedStatusCodeError struct {
@@ -27,6 +47,18 @@ func (e *UnexpectedStatusCodeError) Error() string {
 	return fmt.Sprintf("unexpected status code %d, body: %s", e.StatusCode, e.Body)
 }
 
+// Is reports whether the target is an *[UnexpectedStatusCodeError] with the same status code,
+// so that errors.Is(err, ErrUnauthorized) matches any response with the 401 status code.
+// A target with the zero status code matches any status code.
+func (e *UnexpectedStatusCodeError) Is(target error) bool {
+	targetErr, ok := target.(*UnexpectedStatusCodeError)
+	if !ok {
+		return false
+	}
+
+	return targetErr.StatusCode == 0 || targetErr.StatusCode == e.StatusCode
+}
+
 // UnsupportedResourceError occurs when an unsupported resource is provided.
 type UnsupportedResourceError struct {
 	Resource string
@@ -38,11 +70,54 @@ func (e *UnsupportedResourceError) Error() string {
 }
 
 // FieldNotExistError occurs when trying to get a field by a field name that doesn't exist within a map.
+// The ObjectID holds the id of the object the field is missing in, if it's known.
 type FieldNotExistError struct {
 	FieldName string
+	ObjectID  string
 }
 
 // Error returns a formated error message for the [FieldNotExistError].
 func (e *FieldNotExistError) Error() string {
+	if e.ObjectID != "" {
+		return fmt.Sprintf("field %q doesn't exist in object %q", e.FieldName, e.ObjectID)
+	}
+
 	return fmt.Sprintf("field %q doesn't exist", e.FieldName)
 }
+
+// ContactNotFoundError occurs when there are no contacts with a specific email.
+type ContactNotFoundError struct {
+	Email string
+}
+
+// Error returns a formated error message for the [ContactNotFoundError].
+func (e *ContactNotFoundError) Error() string {
+	return fmt.Sprintf("contact with email %q not found", e.Email)
+}
+
+// AmbiguousContactError occurs when there are multiple contacts with a specific email.
+type AmbiguousContactError struct {
+	Email string
+	Total int
+}
+
+// Error returns a formated error message for the [AmbiguousContactError].
+func (e *AmbiguousContactError) Error() string {
+	return fmt.Sprintf("found %d contacts with email %q", e.Total, e.Email)
+}
+
+// BatchError occurs when a batch request for specific items fails.
+type BatchError struct {
+	IDs []string
+	Err error
+}
+
+// Error returns a formated error message for the [BatchError].
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch of %d items: %v", len(e.IDs), e.Err)
+}
+
+// Unwrap returns the underlying error of the [BatchError].
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
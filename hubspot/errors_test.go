@@ -0,0 +1,82 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUnexpectedStatusCodeError_Is(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "same_status_code",
+			err:    &UnexpectedStatusCodeError{StatusCode: http.StatusUnauthorized, Body: []byte("unauthorized")},
+			target: ErrUnauthorized,
+			want:   true,
+		},
+		{
+			name:   "wrapped_same_status_code",
+			err:    fmt.Errorf("execute request: %w", &UnexpectedStatusCodeError{StatusCode: http.StatusTooManyRequests}),
+			target: ErrTooManyRequests,
+			want:   true,
+		},
+		{
+			name:   "different_status_code",
+			err:    &UnexpectedStatusCodeError{StatusCode: http.StatusForbidden},
+			target: ErrNotFound,
+			want:   false,
+		},
+		{
+			name:   "any_status_code",
+			err:    &UnexpectedStatusCodeError{StatusCode: http.StatusBadGateway},
+			target: &UnexpectedStatusCodeError{},
+			want:   true,
+		},
+		{
+			name:   "different_error_type",
+			err:    &UnexpectedStatusCodeError{StatusCode: http.StatusNotFound},
+			target: &UnsupportedResourceError{Resource: "wrong"},
+			want:   false,
+		},
+		{
+			name:   "not_status_code_error",
+			err:    errors.New("connection refused"),
+			target: ErrForbidden,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// exportPath is a path of the endpoint that starts an asynchronous export.
+	// https://developers.hubspot.com/docs/api/crm/exports
+	exportPath = "/crm/v3/exports/export/async"
+	// exportStatusPath is a path of the endpoint that returns a status of an asynchronous export.
+	exportStatusPath = "/crm/v3/exports/export/async/tasks/%s/status"
+)
+
+// HubSpot export statuses.
+const (
+	ExportStatusPending    = "PENDING"
+	ExportStatusProcessing = "PROCESSING"
+	ExportStatusCanceled   = "CANCELED"
+	ExportStatusComplete   = "COMPLETE"
+)
+
+// ExportFormatCSV is a format of files generated by the [Client.BulkExport] method.
+const ExportFormatCSV = "CSV"
+
+// ResourcesExportObjectTypes holds a mapping of resources that can be exported and their object types.
+var ResourcesExportObjectTypes = map[string]string{
+	"crm.companies": "COMPANY",
+	"crm.contacts":  "CONTACT",
+	"crm.deals":     "DEAL",
+	"crm.lineItems": "LINE_ITEM",
+	"crm.products":  "PRODUCT",
+	"crm.tickets":   "TICKET",
+	"crm.quotes":    "QUOTE",
+	"crm.calls":     "CALL",
+	"crm.emails":    "EMAIL",
+	"crm.meetings":  "MEETING",
+	"crm.notes":     "NOTE",
+	"crm.tasks":     "TASK",
+}
+
+// exportRequest is a request model for the [Client.BulkExport] method.
+type exportRequest struct {
+	ExportType       string   `json:"exportType"`
+	Format           string   `json:"format"`
+	ExportName       string   `json:"exportName"`
+	Language         string   `json:"language"`
+	ObjectType       string   `json:"objectType"`
+	ObjectProperties []string `json:"objectProperties"`
+}
+
+// exportResponse is a response model for the [Client.BulkExport] method.
+type exportResponse struct {
+	ID string `json:"id"`
+}
+
+// ExportStatus is a status of an asynchronous export.
+// The Result holds a download URL of the generated file when the Status is [ExportStatusComplete].
+type ExportStatus struct {
+	Status string `json:"status"`
+	Result string `json:"result"`
+}
+
+// BulkExport starts an asynchronous export of all the items of a specific resource into a CSV file
+// and returns the export's id. The file contains a header with the provided property names.
+// The method raises an *[UnsupportedResourceError] if a provided resource cannot be exported.
+func (c *Client) BulkExport(ctx context.Context, resource string, properties []string) (string, error) {
+	objectType, ok := ResourcesExportObjectTypes[resource]
+	if !ok {
+		return "", &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, exportPath, &exportRequest{
+		ExportType:       "VIEW",
+		Format:           ExportFormatCSV,
+		ExportName:       fmt.Sprintf("conduit %s export", resource),
+		Language:         "EN",
+		ObjectType:       objectType,
+		ObjectProperties: properties,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp exportResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return "", fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.ID, nil
+}
+
+// PollExportStatus retrieves a status of an asynchronous export started by the [Client.BulkExport] method.
+func (c *Client) PollExportStatus(ctx context.Context, exportID string) (*ExportStatus, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf(exportStatusPath, url.PathEscape(exportID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp ExportStatus
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// DownloadExport downloads a file generated by an asynchronous export.
+// The download URL is a pre-signed link, so the request is sent without the access token.
+// The caller is responsible for closing the returned body.
+func (c *Client) DownloadExport(ctx context.Context, downloadURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request with context: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http client do: %w", err)
+	}
+
+	if !isSuccessStatusCode(resp.StatusCode, nil) {
+		defer resp.Body.Close()
+
+		unexpectedStatusCodeErr := &UnexpectedStatusCodeError{
+			StatusCode: resp.StatusCode,
+		}
+
+		unexpectedStatusCodeErr.Body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read resp body: %w", err)
+		}
+
+		return nil, unexpectedStatusCodeErr
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,169 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_BulkExport_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/exports/export/async", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		var req exportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		if req.ObjectType != "CONTACT" {
+			t.Errorf("expected object type to be %q, but got %q", "CONTACT", req.ObjectType)
+		}
+
+		if !reflect.DeepEqual(req.ObjectProperties, []string{"hs_object_id", "email"}) {
+			t.Errorf("unexpected object properties %v", req.ObjectProperties)
+		}
+
+		fmt.Fprint(w, `{"id":"42"}`)
+	})
+
+	exportID, err := client.BulkExport(context.Background(), "crm.contacts", []string{"hs_object_id", "email"})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if exportID != "42" {
+		t.Errorf("expected export id to be %q, but got %q", "42", exportID)
+	}
+}
+
+func TestClient_BulkExport_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.BulkExport(context.Background(), "cms.blogs.posts", nil)
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_PollExportStatus_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/exports/export/async/tasks/42/status", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"status":"COMPLETE","result":"https://example.com/export.csv"}`)
+	})
+
+	status, err := client.PollExportStatus(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := &ExportStatus{
+		Status: ExportStatusComplete,
+		Result: "https://example.com/export.csv",
+	}
+
+	if !reflect.DeepEqual(status, expected) {
+		t.Errorf("expected status to be %v, but got %v", expected, status)
+	}
+}
+
+func TestClient_DownloadExport_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/export.csv", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("expected no authorization header, but got %q", auth)
+		}
+
+		fmt.Fprint(w, "hs_object_id,email\n1,bob@example.com\n")
+	})
+
+	body, err := client.DownloadExport(context.Background(), client.baseURL.String()+"/export.csv")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+
+	if string(content) != "hs_object_id,email\n1,bob@example.com\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+}
+
+func TestClient_DownloadExport_unexpectedStatusCode(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/export.csv", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.DownloadExport(context.Background(), client.baseURL.String()+"/export.csv")
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Fatalf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+
+	if unexpectedStatusCodeErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status code to be %d, but got %d", http.StatusForbidden, unexpectedStatusCodeErr.StatusCode)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// formSubmissionsPath is a path of the endpoint that lists submissions of a form.
+// https://legacydocs.hubspot.com/docs/methods/forms/get-submissions-for-a-form
+const formSubmissionsPath = "/form-integrations/v1/submissions/forms/{formGuid}"
+
+const (
+	// FormSubmissionsFieldSubmittedAt defines a field key for a form submission's submission date.
+	FormSubmissionsFieldSubmittedAt = "submittedAt"
+	// FormSubmissionsFieldPageURL defines a field key for a URL of the page a form was submitted on.
+	FormSubmissionsFieldPageURL = "pageUrl"
+	// FormSubmissionsFieldValues defines a field key for form submission field values.
+	FormSubmissionsFieldValues = "values"
+)
+
+// FormSubmission is a submission of a form.
+// Unlike CRM objects, form submissions have no ids and keep the submitted fields in the values list.
+type FormSubmission struct {
+	SubmittedAt time.Time
+	PageURL     string
+	Values      []FormSubmissionValue
+}
+
+// FormSubmissionValue is a value of a single submitted form field.
+type FormSubmissionValue struct {
+	Name  string
+	Value string
+}
+
+// Value returns the value of a submitted field with a provided name,
+// and whether the field was submitted.
+func (s FormSubmission) Value(name string) (string, bool) {
+	for _, value := range s.Values {
+		if value.Name == name {
+			return value.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// FormSubmission converts a result of a form submissions list into a [FormSubmission].
+// The method returns a *[FieldNotExistError] if the result doesn't have a submission date.
+func (r ListResponseResult) FormSubmission() (FormSubmission, error) {
+	// HubSpot returns submission dates as Unix timestamps in milliseconds.
+	submittedAt, ok := r[FormSubmissionsFieldSubmittedAt].(float64)
+	if !ok {
+		return FormSubmission{}, &FieldNotExistError{
+			FieldName: FormSubmissionsFieldSubmittedAt,
+		}
+	}
+
+	submission := FormSubmission{
+		SubmittedAt: time.UnixMilli(int64(submittedAt)).UTC(),
+	}
+
+	submission.PageURL, _ = r[FormSubmissionsFieldPageURL].(string)
+
+	values, _ := r[FormSubmissionsFieldValues].([]any)
+	for _, value := range values {
+		field, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := field["name"].(string)
+		fieldValue, _ := field["value"].(string)
+
+		submission.Values = append(submission.Values, FormSubmissionValue{
+			Name:  name,
+			Value: fieldValue,
+		})
+	}
+
+	return submission, nil
+}
+
+// GetFormSubmissions returns a list of submissions of a form with a specific guid.
+// Use the [ListResponseResult.FormSubmission] method to access the submitted values.
+func (c *Client) GetFormSubmissions(ctx context.Context, formGUID string, opts *ListOptions) (*ListResponse, error) {
+	return c.getList(ctx, strings.ReplaceAll(formSubmissionsPath, formGUIDPlaceholder, url.PathEscape(formGUID)), opts)
+}
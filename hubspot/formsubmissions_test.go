@@ -0,0 +1,195 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// formSubmissionsResponse is a realistic response of the form submissions endpoint.
+const formSubmissionsResponse = `{
+  "results": [
+    {
+      "submittedAt": 1487966101000,
+      "values": [
+        {"name": "firstname", "value": "Jane", "objectTypeId": "0-1"},
+        {"name": "lastname", "value": "Doe", "objectTypeId": "0-1"},
+        {"name": "email", "value": "jane.doe@example.com", "objectTypeId": "0-1"},
+        {"name": "message", "value": "Please call me back.", "objectTypeId": "0-1"}
+      ],
+      "pageUrl": "https://www.example.com/contact-us"
+    },
+    {
+      "submittedAt": 1487966057000,
+      "values": [
+        {"name": "email", "value": "john.smith@example.com", "objectTypeId": "0-1"}
+      ],
+      "pageUrl": "https://www.example.com/newsletter"
+    }
+  ],
+  "paging": {
+    "next": {
+      "after": "AoJ5pqP+kuMCUmludGVncmF0aW9ucw==",
+      "link": "https://api.hubapi.com/form-integrations/v1/submissions/forms/3c4b3e2d?after=AoJ5pqP"
+    }
+  }
+}`
+
+func TestClient_GetFormSubmissions_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/form-integrations/v1/submissions/forms/3c4b3e2d", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "2" {
+			t.Errorf("expected limit to be %q, but got %q", "2", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(formSubmissionsResponse)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetFormSubmissions(context.Background(), "3c4b3e2d", &ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if len(got.Results) != 2 {
+		t.Fatalf("expected 2 results, but got %d", len(got.Results))
+	}
+
+	if want := "AoJ5pqP+kuMCUmludGVncmF0aW9ucw=="; got.Paging.Next.After != want {
+		t.Errorf("expected paging after to be %q, but got %q", want, got.Paging.Next.After)
+	}
+
+	submission, err := got.Results[0].FormSubmission()
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := FormSubmission{
+		SubmittedAt: time.Date(2017, time.February, 24, 19, 55, 1, 0, time.UTC),
+		PageURL:     "https://www.example.com/contact-us",
+		Values: []FormSubmissionValue{
+			{Name: "firstname", Value: "Jane"},
+			{Name: "lastname", Value: "Doe"},
+			{Name: "email", Value: "jane.doe@example.com"},
+			{Name: "message", Value: "Please call me back."},
+		},
+	}
+
+	if !reflect.DeepEqual(submission, want) {
+		t.Errorf("FormSubmission = %+v, expected %+v", submission, want)
+	}
+}
+
+func TestClient_GetFormSubmissions_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/form-integrations/v1/submissions/forms/3c4b3e2d", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetFormSubmissions(context.Background(), "3c4b3e2d", nil)
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
+
+func TestFormSubmission_Value(t *testing.T) {
+	t.Parallel()
+
+	submission := FormSubmission{
+		Values: []FormSubmissionValue{
+			{Name: "email", Value: "jane.doe@example.com"},
+			{Name: "company", Value: ""},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		field     string
+		wantValue string
+		wantOK    bool
+	}{
+		{
+			name:      "submitted",
+			field:     "email",
+			wantValue: "jane.doe@example.com",
+			wantOK:    true,
+		},
+		{
+			name:      "submitted_empty",
+			field:     "company",
+			wantValue: "",
+			wantOK:    true,
+		},
+		{
+			name:      "not_submitted",
+			field:     "phone",
+			wantValue: "",
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, ok := submission.Value(tt.field)
+			if value != tt.wantValue || ok != tt.wantOK {
+				t.Errorf("Value() = (%q, %v), want (%q, %v)", value, ok, tt.wantValue, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestListResponseResult_FormSubmission_missingSubmittedAt(t *testing.T) {
+	t.Parallel()
+
+	_, err := ListResponseResult{FormSubmissionsFieldPageURL: "https://www.example.com"}.FormSubmission()
+
+	var fieldNotExistErr *FieldNotExistError
+	if !errors.As(err, &fieldNotExistErr) {
+		t.Fatalf("expected error to be FieldNotExistError, but got %v", err)
+	}
+
+	if fieldNotExistErr.FieldName != FormSubmissionsFieldSubmittedAt {
+		t.Errorf("expected field name %q, but got %q", FormSubmissionsFieldSubmittedAt, fieldNotExistErr.FieldName)
+	}
+}
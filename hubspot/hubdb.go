@@ -0,0 +1,120 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developers.hubspot.com/docs/api/cms/hubdb
+const (
+	// hubDBPublishTablePath is a path of the endpoint that publishes a draft HubDB table.
+	hubDBPublishTablePath = "/cms/v3/hubdb/tables/%s/draft/publish"
+	// hubDBTableRowsPath is a path of the endpoint that lists and creates rows of a HubDB table.
+	hubDBTableRowsPath = "/cms/v3/hubdb/tables/%s/rows"
+)
+
+// HubDBResultsFieldValues defines a field key for HubDB row cells.
+const HubDBResultsFieldValues = "values"
+
+// HubDBListResponseResult is a HubDB table row.
+// Unlike CRM objects, HubDB rows have numeric ids and keep their cells in the values map.
+type HubDBListResponseResult struct {
+	ID     int64
+	Values map[string]any
+}
+
+// HubDBRow converts a result of a HubDB table rows list into a [HubDBListResponseResult].
+// The method returns a *[FieldNotExistError] if the result doesn't have an id.
+func (r ListResponseResult) HubDBRow() (HubDBListResponseResult, error) {
+	var row HubDBListResponseResult
+
+	// HubSpot returns row ids within strings, but they may also be decoded as JSON numbers.
+	switch id := r[ResultsFieldID].(type) {
+	case string:
+		var err error
+
+		row.ID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return HubDBListResponseResult{}, fmt.Errorf("parse row id: %w", err)
+		}
+
+	case float64:
+		row.ID = int64(id)
+
+	default:
+		return HubDBListResponseResult{}, &FieldNotExistError{
+			FieldName: ResultsFieldID,
+		}
+	}
+
+	row.Values, _ = r[HubDBResultsFieldValues].(map[string]any)
+
+	return row, nil
+}
+
+// GetHubDBTableRows returns a list of draft rows of a HubDB table with a specific id.
+// Use the [ListResponseResult.HubDBRow] method to access the row's cells.
+func (c *Client) GetHubDBTableRows(ctx context.Context, tableID string, opts *ListOptions) (*ListResponse, error) {
+	resourcePath, err := addOptions(fmt.Sprintf(hubDBTableRowsPath, url.PathEscape(tableID)), opts)
+	if err != nil {
+		return nil, fmt.Errorf("add options: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp ListResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// CreateHubDBRow creates a new draft row with the provided cell values in a HubDB table with a specific id.
+func (c *Client) CreateHubDBRow(ctx context.Context, tableID string, values map[string]any) error {
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf(hubDBTableRowsPath, url.PathEscape(tableID)),
+		map[string]any{HubDBResultsFieldValues: values})
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
+
+// PublishHubDBTable publishes a draft version of a HubDB table, copying its data to the live version.
+func (c *Client) PublishHubDBTable(ctx context.Context, tableID string) error {
+	req, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf(hubDBPublishTablePath, url.PathEscape(tableID)), nil)
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
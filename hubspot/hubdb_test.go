@@ -0,0 +1,160 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_PublishHubDBTable_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/draft/publish", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.PublishHubDBTable(context.Background(), "42")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_PublishHubDBTable_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/draft/publish", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.PublishHubDBTable(context.Background(), "42")
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
+
+func TestClient_GetHubDBTableRows(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/rows", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit to be %q, but got %q", "10", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"total": 1, "results": [{"id": "1001", "createdAt": "2022-10-28T14:58:27Z", ` +
+			`"values": {"name": "Bob", "age": 30}}]}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetHubDBTableRows(context.Background(), "42", &ListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if got.Total != 1 || len(got.Results) != 1 {
+		t.Fatalf("expected a single row, but got %v", got)
+	}
+
+	row, err := got.Results[0].HubDBRow()
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := HubDBListResponseResult{
+		ID:     1001,
+		Values: map[string]any{"name": "Bob", "age": float64(30)},
+	}
+
+	if !reflect.DeepEqual(row, want) {
+		t.Errorf("HubDBRow() = %v, want %v", row, want)
+	}
+}
+
+func TestListResponseResult_HubDBRow_missingID(t *testing.T) {
+	t.Parallel()
+
+	_, err := ListResponseResult{"values": map[string]any{"name": "Bob"}}.HubDBRow()
+
+	var fieldNotExistErr *FieldNotExistError
+	if !errors.As(err, &fieldNotExistErr) {
+		t.Errorf("expected error to be FieldNotExistError, but got %v", err)
+	}
+}
+
+func TestClient_CreateHubDBRow(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/rows", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		reqBody, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("expected error to be nil, but got %v", err)
+		}
+
+		expected := `{"values":{"name":"Bob"}}` + "\n"
+		if string(reqBody) != expected {
+			t.Errorf("Request body = %v, expected %v", string(reqBody), expected)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := client.CreateHubDBRow(context.Background(), "42", map[string]any{"name": "Bob"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
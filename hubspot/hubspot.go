@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -35,22 +36,44 @@ const (
 	defaultBaseURL = "https://api.hubapi.com"
 	// objectIDPlaceholder is a placeholder for an object id.
 	objectIDPlaceholder = "{objectId}"
+	// tableIDPlaceholder is a placeholder for a HubDB table id.
+	tableIDPlaceholder = "{tableId}"
+	// threadIDPlaceholder is a placeholder for a conversation thread id.
+	threadIDPlaceholder = "{threadId}"
+	// listIDPlaceholder is a placeholder for a contact list id.
+	listIDPlaceholder = "{listId}"
+	// formGUIDPlaceholder is a placeholder for a form guid.
+	formGUIDPlaceholder = "{formGuid}"
 	// defaultHTTPClientTimeout is a default timeout that is used with the default HTTP client.
 	defaultHTTPClientTimeout = time.Second * 10
 )
 
 // A Client manages communication with the HubSpot API.
 type Client struct {
-	accessToken string
 	httpClient  *http.Client
+	accessToken string
 	baseURL     *url.URL
+	hubDB       HubDBResource
+	tracer      Tracer
+	// middlewares are run on each request before it's sent.
+	middlewares []RequestMiddleware
+	// circuitBreaker stops sending requests while the HubSpot API is unavailable, it's nil if it's disabled.
+	circuitBreaker *circuitBreaker
 }
 
+// ClientOption is a functional option for the [NewClient] function.
+type ClientOption func(*Client)
+
 // NewClient creates a new instance of the Client.
-func NewClient(accessToken string, httpClient *http.Client) *Client {
+func NewClient(accessToken string, httpClient *http.Client, opts ...ClientOption) *Client {
 	client := &Client{
-		accessToken: accessToken,
 		httpClient:  httpClient,
+		accessToken: accessToken,
+		middlewares: []RequestMiddleware{BearerTokenMiddleware(accessToken)},
+	}
+
+	for _, opt := range opts {
+		opt(client)
 	}
 
 	// if the HTTP client is empty - we'll use a default one with the defaultHTTPClientTimeout.
@@ -66,6 +89,20 @@ func NewClient(accessToken string, httpClient *http.Client) *Client {
 	return client
 }
 
+// SetHubDBResource sets a HubDB resource that is used to construct paths of HubDB table rows.
+func (c *Client) SetHubDBResource(resource HubDBResource) {
+	c.hubDB = resource
+}
+
+// SetHTTPTransport replaces the transport of the Client's HTTP client, e.g. to use a proxy or custom TLS settings.
+// The HTTP client is copied, so the one passed to the [NewClient] is not modified.
+func (c *Client) SetHTTPTransport(transport http.RoundTripper) {
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+
+	c.httpClient = &httpClient
+}
+
 // newRequest creates an API request.
 func (c *Client) newRequest(ctx context.Context, method, path string, body any) (*http.Request, error) {
 	reqURL, err := c.baseURL.Parse(path)
@@ -90,48 +127,98 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body any)
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.accessToken))
-
 	return req, nil
 }
 
 // do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by out, or returned as an
 // error if an API error has occurred.
-func (c *Client) do(req *http.Request, out any) error {
+// If the successCodes are empty, any status code from 200 to 204 is considered successful.
+// The Client's middlewares are run on the request before it's sent.
+// If the Client has a tracer, the request is wrapped into a span.
+// If the Client has a circuit breaker and its circuit is open, the [ErrCircuitOpen] is returned right away.
+func (c *Client) do(req *http.Request, out any, successCodes []int) error {
+	if err := c.applyMiddlewares(req); err != nil {
+		return fmt.Errorf("apply request middlewares: %w", err)
+	}
+
+	if err := c.circuitBreaker.allow(); err != nil {
+		return err
+	}
+
+	if c.tracer == nil {
+		statusCode, err := c.send(req, out, successCodes)
+		c.circuitBreaker.record(req, statusCode, err)
+
+		return err
+	}
+
+	ctx, span := c.tracer.Start(req.Context(), fmt.Sprintf("hubspot.%s %s", req.Method, req.URL.Path))
+	defer span.End()
+
+	req = req.WithContext(ctx)
+	c.tracer.Inject(ctx, req.Header)
+
+	statusCode, err := c.send(req, out, successCodes)
+	c.circuitBreaker.record(req, statusCode, err)
+
+	if statusCode != 0 {
+		span.SetStatusCode(statusCode)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+// send sends an API request and decodes its response into the value pointed to by out.
+// It returns the response's status code, or zero if the request has failed to send.
+func (c *Client) send(req *http.Request, out any, successCodes []int) (int, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("http client do: %w", err)
+		return 0, fmt.Errorf("http client do: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent {
+	if !isSuccessStatusCode(resp.StatusCode, successCodes) {
 		unexpectedStatusCodeErr := &UnexpectedStatusCodeError{
 			StatusCode: resp.StatusCode,
 		}
 
 		unexpectedStatusCodeErr.Body, err = io.ReadAll(resp.Body)
 		if err != nil {
-			return fmt.Errorf("read resp body: %w", err)
+			return resp.StatusCode, fmt.Errorf("read resp body: %w", err)
 		}
 
-		return unexpectedStatusCodeErr
+		return resp.StatusCode, unexpectedStatusCodeErr
 	}
 
 	switch out := out.(type) {
 	case nil:
 	case io.Writer:
 		if _, err = io.Copy(out, resp.Body); err != nil {
-			return fmt.Errorf("copy resp.Body: %w", err)
+			return resp.StatusCode, fmt.Errorf("copy resp.Body: %w", err)
 		}
 
 	default:
 		if err = json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
-			return fmt.Errorf("decode resp.Body: %w", err)
+			return resp.StatusCode, fmt.Errorf("decode resp.Body: %w", err)
 		}
 	}
 
-	return nil
+	return resp.StatusCode, nil
+}
+
+// isSuccessStatusCode checks if a provided status code is one of the success codes.
+// If the success codes are empty, the status code must be in the range from 200 to 204.
+func isSuccessStatusCode(statusCode int, successCodes []int) bool {
+	if len(successCodes) == 0 {
+		return statusCode >= http.StatusOK && statusCode <= http.StatusNoContent
+	}
+
+	return slices.Contains(successCodes, statusCode)
 }
 
 // addOptions adds the parameters in opts as URL query parameters to s. opts
@@ -69,11 +69,6 @@ func TestClient_newRequest(t *testing.T) {
 	if got, want := string(body), outBody; got != want {
 		t.Errorf("NewRequest(%q) Body is %v, want %v", inBody, got, want)
 	}
-
-	// test that Authorization HTTP headers is applied
-	if got, want := req.Header.Get("Authorization"), "Bearer secret"; got != want {
-		t.Errorf("NewRequest() Authorization is %v, want %v", got, want)
-	}
 }
 
 func TestClient_newRequest_invalidJSON(t *testing.T) {
@@ -155,7 +150,7 @@ func TestClient_do_get(t *testing.T) {
 	req, _ := client.newRequest(context.Background(), http.MethodGet, "/", nil)
 	body := new(command)
 
-	if err := client.do(req, body); err != nil {
+	if err := client.do(req, body, nil); err != nil {
 		t.Fatalf("Do(): %v", err)
 	}
 
@@ -168,7 +163,7 @@ func TestClient_do_get(t *testing.T) {
 	req, _ = client.newRequest(context.Background(), http.MethodGet, "/", nil)
 	buf := bytes.NewBuffer(nil)
 
-	if err := client.do(req, buf); err != nil {
+	if err := client.do(req, buf, nil); err != nil {
 		t.Fatalf("Do(): %v", err)
 	}
 
@@ -222,7 +217,7 @@ func TestClient_do_post(t *testing.T) {
 
 	req, _ := client.newRequest(context.Background(), http.MethodPost, "/", reqBody)
 
-	if err := client.do(req, nil); err != nil {
+	if err := client.do(req, nil, nil); err != nil {
 		t.Fatalf("Do(): %v", err)
 	}
 }
@@ -242,7 +237,39 @@ func TestClient_do_httpError(t *testing.T) {
 
 	req, _ := client.newRequest(context.Background(), http.MethodGet, "/", nil)
 
-	err := client.do(req, nil)
+	err := client.do(req, nil, nil)
+	if err == nil {
+		t.Errorf("Expected error to be returned")
+	}
+
+	var expectedError *UnexpectedStatusCodeError
+	if !errors.As(err, &expectedError) {
+		t.Errorf("Expected Unexpected Status Code error, got %+v", err)
+	}
+}
+
+func TestClient_do_successCodes(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	req, _ := client.newRequest(context.Background(), http.MethodGet, "/", nil)
+
+	if err := client.do(req, nil, []int{http.StatusAccepted}); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	req, _ = client.newRequest(context.Background(), http.MethodGet, "/", nil)
+
+	err := client.do(req, nil, []int{http.StatusOK})
 	if err == nil {
 		t.Errorf("Expected error to be returned")
 	}
@@ -269,7 +296,7 @@ func TestClient_do_nilURL(t *testing.T) {
 	req, _ := client.newRequest(context.Background(), http.MethodGet, "/", nil)
 	req.URL = nil
 
-	err := client.do(req, nil)
+	err := client.do(req, nil, nil)
 	if err == nil {
 		t.Errorf("Expected error to be returned")
 	}
@@ -302,7 +329,7 @@ func TestClient_do_invalidJSON(t *testing.T) {
 	req, _ := client.newRequest(context.Background(), http.MethodGet, "/", nil)
 	body := new(command)
 
-	err := client.do(req, &body)
+	err := client.do(req, &body, nil)
 	if err == nil {
 		t.Errorf("Expected error to be returned")
 	}
@@ -312,3 +339,47 @@ func TestClient_do_invalidJSON(t *testing.T) {
 		t.Errorf("Expected a JSON error; got %#v.", errors.Unwrap(err))
 	}
 }
+
+// roundTripperFunc is an adapter to use a function as an [http.RoundTripper].
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_SetHTTPTransport(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/integrations/v1/me", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"portalId":62515}`)
+	})
+
+	originalHTTPClient := client.httpClient
+	originalTransport := originalHTTPClient.Transport
+
+	var calls int
+	client.SetHTTPTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+
+		return originalTransport.RoundTrip(req)
+	}))
+
+	if _, err := client.GetPortalInfo(context.Background()); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the transport to be called once, but it was called %d times", calls)
+	}
+
+	if originalHTTPClient.Transport != originalTransport {
+		t.Errorf("expected the original HTTP client not to be modified")
+	}
+}
@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -35,6 +38,16 @@ type TimestampResource struct {
 	DeletedAtFieldName string
 }
 
+// HubDBResource holds a table id of a HubDB resource.
+type HubDBResource struct {
+	TableID string
+}
+
+// resolvePath replaces the table id placeholder of a provided path with the resource's table id.
+func (r HubDBResource) resolvePath(path string) string {
+	return strings.ReplaceAll(path, tableIDPlaceholder, url.PathEscape(r.TableID))
+}
+
 // TimestampResources holds a list of resources that support timestamp-based filtering.
 var TimestampResources = map[string]TimestampResource{
 	"cms.blogs.authors": {
@@ -66,10 +79,35 @@ var TimestampResources = map[string]TimestampResource{
 		CreatedAtFieldName: "createdAt",
 		UpdatedAtFieldName: "updatedAt",
 	},
+	"cms.hubdb.rows": {
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+	},
 	"cms.urlRedirects": {
 		CreatedAtFieldName: "createdAt",
 		UpdatedAtFieldName: "updatedAt",
 	},
+	"cms.domains": {
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+	},
+	"cms.forms": {
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+	},
+	ConversationMessagesResource: {
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+	},
+	"marketing.emails": {
+		CreatedAtFieldName: "created",
+		UpdatedAtFieldName: "updated",
+		DeletedAtFieldName: "deletedAt",
+	},
+	WorkflowsResource: {
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+	},
 }
 
 // ResourcesListPaths holds a mapping of supported resources and their list endpoints.
@@ -85,8 +123,13 @@ var ResourcesListPaths = map[string]string{
 	"cms.pages.site":    "/cms/v3/pages/site-pages",
 	// https://developers.hubspot.com/docs/api/cms/hubdb
 	"cms.hubdb.tables": "/cms/v3/hubdb/tables",
+	"cms.hubdb.rows":   "/cms/v3/hubdb/tables/{tableId}/rows",
 	// https://developers.hubspot.com/docs/api/cms/url-redirects
 	"cms.urlRedirects": "/cms/v3/url-redirects",
+	// https://developers.hubspot.com/docs/api/cms/domains
+	"cms.domains": "/cms/v3/domains",
+	// https://developers.hubspot.com/docs/api/marketing/forms
+	"cms.forms": "/marketing/v3/forms",
 	// https://developers.hubspot.com/docs/api/crm/companies
 	"crm.companies": "/crm/v3/objects/companies",
 	// https://developers.hubspot.com/docs/api/crm/contacts
@@ -103,6 +146,7 @@ var ResourcesListPaths = map[string]string{
 	"crm.tickets": "/crm/v3/objects/tickets",
 	// https://developers.hubspot.com/docs/api/crm/quotes
 	"crm.quotes": "/crm/v3/objects/quotes",
+	// https://developers.hubspot.com/docs/api/crm/lists
 	// https://developers.hubspot.com/docs/api/crm/calls
 	"crm.calls": "/crm/v3/objects/calls",
 	// https://developers.hubspot.com/docs/api/crm/email
@@ -113,6 +157,16 @@ var ResourcesListPaths = map[string]string{
 	"crm.notes": "/crm/v3/objects/notes",
 	// https://developers.hubspot.com/docs/api/crm/tasks
 	"crm.tasks": "/crm/v3/objects/tasks",
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": "/crm/v3/objects/postal_mail",
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": "/crm/v3/objects/task_queues",
+	// https://developers.hubspot.com/docs/api/marketing/marketing-email
+	"marketing.emails": "/marketing/v3/emails",
+	// https://developers.hubspot.com/docs/api/automation/workflows
+	WorkflowsResource: workflowsPath,
+	// https://developers.hubspot.com/docs/api/conversations/conversations
+	ConversationMessagesResource: conversationMessagesPath,
 }
 
 // ListOptions holds optional params for the [List] method.
@@ -124,6 +178,7 @@ type ListOptions struct {
 	CreatedBefore *time.Time `url:"createdBefore,omitempty" layout:"2006-01-02T15:04:05.000Z"`
 	Sort          string     `url:"sort,omitempty"`
 	Archived      bool       `url:"archived,omitempty"`
+	Properties    []string   `url:"properties,comma,omitempty"`
 }
 
 // ListResponse is a common response model for endpoints that returns a list of results.
@@ -134,9 +189,53 @@ type ListResponse struct {
 	Paging  *ListResponsePaging  `json:"paging,omitempty"`
 }
 
+// HasMore returns a bool indicating whether there are more items to retrieve after the response.
+func (r *ListResponse) HasMore() bool {
+	return r.Paging != nil && r.Paging.Next.After != ""
+}
+
+// NextAfter returns the cursor of the next page, or an empty string if there's no next page.
+func (r *ListResponse) NextAfter() string {
+	if r.Paging == nil {
+		return ""
+	}
+
+	return r.Paging.Next.After
+}
+
+// NextLink returns the link to the next page, or an empty string if there's no next page.
+// Only timestamp-based resources return it.
+func (r *ListResponse) NextLink() string {
+	if r.Paging == nil {
+		return ""
+	}
+
+	return r.Paging.Next.Link
+}
+
 // ListResponseResult is a result object for the [ListResponse].
 type ListResponseResult map[string]any
 
+// Properties returns the item's properties field value. The returned map is not a copy,
+// so changes to it are reflected in the item. If the item has no properties or they're not an object,
+// an empty non-nil map is returned.
+func (r ListResponseResult) Properties() map[string]any {
+	properties, ok := r[propertiesFieldName].(map[string]any)
+	if !ok {
+		return map[string]any{}
+	}
+
+	return properties
+}
+
+// Property returns a string value of the item's property by a provided name.
+// The second return value reports whether the property exists and is a string.
+func (r ListResponseResult) Property(name string) (string, bool) {
+	value, ok := r.Properties()[name].(string)
+
+	return value, ok
+}
+
 // GetCreatedAt returns the item's createdAt field value.
 func (r ListResponseResult) GetCreatedAt(resource string) (time.Time, error) {
 	if resource, ok := TimestampResources[resource]; ok {
@@ -178,8 +277,13 @@ func (r ListResponseResult) GetDeletedAt(resource string) (time.Time, error) {
 func (r ListResponseResult) GetTimeField(name string) (time.Time, error) {
 	field, ok := r[name].(string)
 	if !ok {
+		// the id is a string for all the supported resources,
+		// so it's okay to leave it empty otherwise.
+		objectID, _ := r[ResultsFieldID].(string)
+
 		return time.Time{}, &FieldNotExistError{
 			FieldName: name,
+			ObjectID:  objectID,
 		}
 	}
 
@@ -213,6 +317,15 @@ func (c *Client) List(ctx context.Context, resource string, opts *ListOptions) (
 		}
 	}
 
+	// the thread messages are listed by a thread id, which is passed to the GetConversationMessages method.
+	if resourcePath == conversationMessagesPath {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	resourcePath = c.hubDB.resolvePath(resourcePath)
+
 	resourcePath, err := addOptions(resourcePath, opts)
 	if err != nil {
 		return nil, fmt.Errorf("add options: %w", err)
@@ -224,13 +337,36 @@ func (c *Client) List(ctx context.Context, resource string, opts *ListOptions) (
 	}
 
 	var resp ListResponse
-	if err := c.do(req, &resp); err != nil {
+	if err := c.do(req, &resp, nil); err != nil {
 		return nil, fmt.Errorf("execute request: %w", err)
 	}
 
 	return &resp, nil
 }
 
+// ListWithProperties retrieves a list of items of a specific resource including the provided properties.
+// The properties are merged into the opts, which are left unchanged.
+func (c *Client) ListWithProperties(
+	ctx context.Context,
+	resource string,
+	properties []string,
+	opts *ListOptions,
+) (*ListResponse, error) {
+	var listOpts ListOptions
+	if opts != nil {
+		listOpts = *opts
+	}
+
+	listOpts.Properties = slices.Clone(listOpts.Properties)
+	for _, property := range properties {
+		if !slices.Contains(listOpts.Properties, property) {
+			listOpts.Properties = append(listOpts.Properties, property)
+		}
+	}
+
+	return c.List(ctx, resource, &listOpts)
+}
+
 // ListByNextLink retrieves a list of items by a next link.
 // It doesn't require specifying filters and resources manually.
 func (c *Client) ListByNextLink(ctx context.Context, nextLink string) (*ListResponse, error) {
@@ -240,7 +376,7 @@ func (c *Client) ListByNextLink(ctx context.Context, nextLink string) (*ListResp
 	}
 
 	var resp ListResponse
-	if err := c.do(req, &resp); err != nil {
+	if err := c.do(req, &resp, nil); err != nil {
 		return nil, fmt.Errorf("execute request: %w", err)
 	}
 
@@ -19,7 +19,9 @@ import (
 	"errors"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_List_success(t *testing.T) {
@@ -86,6 +88,172 @@ func TestClient_List_withOptions(t *testing.T) {
 	}
 }
 
+func TestClient_ListWithProperties(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts", func(_ http.ResponseWriter, r *http.Request) {
+		expectedProperties := "email,firstname"
+
+		if properties := r.URL.Query().Get("properties"); properties != expectedProperties {
+			t.Errorf("properties = %v, want = %v", properties, expectedProperties)
+		}
+
+		expectedLimit := "1"
+
+		if limit := r.URL.Query().Get("limit"); limit != expectedLimit {
+			t.Errorf("limit = %v, want = %v", limit, expectedLimit)
+		}
+	})
+
+	opts := &ListOptions{
+		Limit:      1,
+		Properties: []string{"email"},
+	}
+
+	_, err := client.ListWithProperties(context.Background(), "crm.contacts", []string{"email", "firstname"}, opts)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if len(opts.Properties) != 1 {
+		t.Errorf("expected opts to be unchanged, but got properties %v", opts.Properties)
+	}
+}
+
+func TestClient_List_cmsDomains(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/domains", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"total": 1, "results": [{"id": "1", "domain": "example.com", ` +
+				`"createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-28T14:58:27Z"}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "cms.domains", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total: 1,
+		Results: []ListResponseResult{{
+			"id":        "1",
+			"domain":    "example.com",
+			"createdAt": "2022-10-28T14:58:27Z",
+			"updatedAt": "2022-10-28T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_List_marketingEmails(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/emails", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"total": 1, "results": [{"id": "1", "name": "Newsletter", ` +
+				`"created": "2022-10-28T14:58:27Z", "updated": "2022-10-28T14:58:27Z"}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "marketing.emails", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total: 1,
+		Results: []ListResponseResult{{
+			"id":      "1",
+			"name":    "Newsletter",
+			"created": "2022-10-28T14:58:27Z",
+			"updated": "2022-10-28T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_List_cmsForms(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/forms", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"results": [{"id": "1", "name": "Contact us", ` +
+				`"createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-28T14:58:27Z"}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "cms.forms", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"id":        "1",
+			"name":      "Contact us",
+			"createdAt": "2022-10-28T14:58:27Z",
+			"updatedAt": "2022-10-28T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+
+	updatedAt, err := got.Results[0].GetUpdatedAt("cms.forms")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if wantUpdatedAt := time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC); !updatedAt.Equal(wantUpdatedAt) {
+		t.Errorf("expected updatedAt to be %v, but got %v", wantUpdatedAt, updatedAt)
+	}
+}
+
 func TestClient_List_unsupportedResource(t *testing.T) {
 	t.Parallel()
 
@@ -105,3 +273,324 @@ func TestClient_List_unsupportedResource(t *testing.T) {
 		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
 	}
 }
+
+func TestClient_List_hubDBRows(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	client.SetHubDBResource(HubDBResource{TableID: "123"})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/123/rows", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"results": [{"id": "1", "values": {"name": "hello"}}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "cms.hubdb.rows", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{"id": "1", "values": map[string]any{"name": "hello"}}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestListResponseResult_GetTimeField_fieldNotExist(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		result  ListResponseResult
+		wantErr *FieldNotExistError
+		wantMsg string
+	}{
+		{
+			name:    "with_object_id",
+			result:  ListResponseResult{"id": "1"},
+			wantErr: &FieldNotExistError{FieldName: "createdAt", ObjectID: "1"},
+			wantMsg: `field "createdAt" doesn't exist in object "1"`,
+		},
+		{
+			name:    "without_object_id",
+			result:  ListResponseResult{},
+			wantErr: &FieldNotExistError{FieldName: "createdAt"},
+			wantMsg: `field "createdAt" doesn't exist`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.result.GetTimeField("createdAt")
+
+			var fieldNotExistErr *FieldNotExistError
+			if !errors.As(err, &fieldNotExistErr) {
+				t.Fatalf("expected error to be FieldNotExistError, but got %v", err)
+			}
+
+			if !reflect.DeepEqual(fieldNotExistErr, tt.wantErr) {
+				t.Errorf("expected error to be %+v, but got %+v", tt.wantErr, fieldNotExistErr)
+			}
+
+			if err.Error() != tt.wantMsg {
+				t.Errorf("expected error message to be %q, but got %q", tt.wantMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestListResponse_HasMore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		response      ListResponse
+		wantHasMore   bool
+		wantNextAfter string
+		wantNextLink  string
+	}{
+		{
+			name:     "nil_paging",
+			response: ListResponse{},
+		},
+		{
+			name: "empty_after",
+			response: ListResponse{
+				Paging: &ListResponsePaging{},
+			},
+		},
+		{
+			name: "non_empty_after",
+			response: ListResponse{
+				Paging: &ListResponsePaging{
+					Next: ListResponsePagingNext{After: "2"},
+				},
+			},
+			wantHasMore:   true,
+			wantNextAfter: "2",
+		},
+		{
+			name: "non_empty_link",
+			response: ListResponse{
+				Paging: &ListResponsePaging{
+					Next: ListResponsePagingNext{Link: "https://api.hubapi.com/next"},
+				},
+			},
+			wantNextLink: "https://api.hubapi.com/next",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.response.HasMore(); got != tt.wantHasMore {
+				t.Errorf("HasMore() = %v, expected %v", got, tt.wantHasMore)
+			}
+
+			if got := tt.response.NextAfter(); got != tt.wantNextAfter {
+				t.Errorf("NextAfter() = %q, expected %q", got, tt.wantNextAfter)
+			}
+
+			if got := tt.response.NextLink(); got != tt.wantNextLink {
+				t.Errorf("NextLink() = %q, expected %q", got, tt.wantNextLink)
+			}
+		})
+	}
+}
+
+func TestClient_List_crmPostalMail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/postal_mail", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"results": [{"id": "1", "properties": {"hs_postal_mail_body": "Welcome letter"}, ` +
+				`"createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-28T14:58:27Z", "archived": false}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "crm.postalMail", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"id":         "1",
+			"properties": map[string]any{"hs_postal_mail_body": "Welcome letter"},
+			"createdAt":  "2022-10-28T14:58:27Z",
+			"updatedAt":  "2022-10-28T14:58:27Z",
+			"archived":   false,
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_List_crmTaskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write(
+			[]byte(`{"results": [{"id": "1", "properties": {"hs_task_queues_body": "Renewals"}, ` +
+				`"createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-28T14:58:27Z", "archived": false}]}`),
+		)
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.List(context.Background(), "crm.taskQueues", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"id":         "1",
+			"properties": map[string]any{"hs_task_queues_body": "Renewals"},
+			"createdAt":  "2022-10-28T14:58:27Z",
+			"updatedAt":  "2022-10-28T14:58:27Z",
+			"archived":   false,
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestResourcesListPaths_crmResources(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources map[string]string
+	}{
+		{name: "schema", resources: ResourcesSchemaPaths},
+		{name: "batch_read", resources: ResourcesBatchReadPaths},
+		{name: "batch_upsert", resources: ResourcesBatchUpsertPaths},
+		{name: "batch_create", resources: ResourcesBatchCreatePaths},
+		{name: "batch_update", resources: ResourcesBatchUpdatePaths},
+		{name: "batch_archive", resources: ResourcesBatchArchivePaths},
+		{name: "association_object_types", resources: ResourcesAssociationObjectTypes},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for resource := range ResourcesListPaths {
+				if !strings.HasPrefix(resource, "crm.") {
+					continue
+				}
+
+				// the feedback submissions are read-only, so they cannot be associated with other objects.
+				if tt.name == "association_object_types" && resource == "crm.feedbackSubmissions" {
+					continue
+				}
+
+				if _, ok := tt.resources[resource]; !ok {
+					t.Errorf("the list resource %q is missing from the %s map", resource, tt.name)
+				}
+			}
+		})
+	}
+}
+
+func TestListResponseResult_Properties(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		result       ListResponseResult
+		want         map[string]any
+		wantProperty string
+		wantOK       bool
+	}{
+		{
+			name:         "success",
+			result:       ListResponseResult{"id": "1", "properties": map[string]any{"email": "bob@example.com"}},
+			want:         map[string]any{"email": "bob@example.com"},
+			wantProperty: "bob@example.com",
+			wantOK:       true,
+		},
+		{
+			name:   "missing_properties",
+			result: ListResponseResult{"id": "1"},
+			want:   map[string]any{},
+		},
+		{
+			name:   "non_map_properties",
+			result: ListResponseResult{"id": "1", "properties": "email"},
+			want:   map[string]any{},
+		},
+		{
+			name:   "missing_property",
+			result: ListResponseResult{"id": "1", "properties": map[string]any{"firstname": "Bob"}},
+			want:   map[string]any{"firstname": "Bob"},
+		},
+		{
+			name:   "non_string_property",
+			result: ListResponseResult{"id": "1", "properties": map[string]any{"email": 1}},
+			want:   map[string]any{"email": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.result.Properties(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Properties() = %v, want %v", got, tt.want)
+			}
+
+			got, ok := tt.result.Property("email")
+			if got != tt.wantProperty || ok != tt.wantOK {
+				t.Errorf("Property() = %q, %v, want %q, %v", got, ok, tt.wantProperty, tt.wantOK)
+			}
+		})
+	}
+}
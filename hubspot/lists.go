@@ -0,0 +1,63 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// https://developers.hubspot.com/docs/api/crm/lists
+const (
+	// contactListsPath is a path of the endpoint that lists static and dynamic contact lists.
+	contactListsPath = "/crm/v3/lists"
+	// contactListMembershipsPath is a path of the endpoint that lists members of a contact list
+	// in the order they joined the list.
+	contactListMembershipsPath = "/crm/v3/lists/{listId}/memberships/join-order"
+)
+
+// GetContactLists returns a list of static and dynamic contact lists.
+func (c *Client) GetContactLists(ctx context.Context, opts *ListOptions) (*ListResponse, error) {
+	return c.getList(ctx, contactListsPath, opts)
+}
+
+// GetListMembership returns a list of members of a contact list with a specific id.
+func (c *Client) GetListMembership(ctx context.Context, listID string, opts *ListOptions) (*ListResponse, error) {
+	return c.getList(ctx, strings.ReplaceAll(contactListMembershipsPath, listIDPlaceholder, url.PathEscape(listID)), opts)
+}
+
+// getList retrieves a list of items from a provided path.
+// The opts are encoded into query params, they're either *[ListOptions] or a struct embedding it.
+func (c *Client) getList(ctx context.Context, path string, opts any) (*ListResponse, error) {
+	resourcePath, err := addOptions(path, opts)
+	if err != nil {
+		return nil, fmt.Errorf("add options: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp ListResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,151 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetContactLists_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/lists", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("expected limit to be %q, but got %q", "10", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": [{"listId": "7", "name": "Newsletter", "processingType": "MANUAL"}], ` +
+			`"paging": {"next": {"after": "8"}}}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetContactLists(context.Background(), &ListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"listId":         "7",
+			"name":           "Newsletter",
+			"processingType": "MANUAL",
+		}},
+		Paging: &ListResponsePaging{
+			Next: ListResponsePagingNext{
+				After: "8",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_GetContactLists_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/lists", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.GetContactLists(context.Background(), nil)
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
+
+func TestClient_GetListMembership_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/lists/7/memberships/join-order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got := r.URL.Query().Get("after"); got != "abc" {
+			t.Errorf("expected after to be %q, but got %q", "abc", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": [{"recordId": "101", "membershipTimestamp": "2022-10-28T14:58:27Z"}]}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.GetListMembership(context.Background(), "7", &ListOptions{After: "abc"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Results: []ListResponseResult{{
+			"recordId":            "101",
+			"membershipTimestamp": "2022-10-28T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_GetListMembership_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/lists/7/memberships/join-order", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetListMembership(context.Background(), "7", nil)
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RequestMiddleware mutates a HubSpot API request before it's sent, for example by setting its headers.
+// If a middleware returns an error, the request is not sent.
+type RequestMiddleware func(req *http.Request) error
+
+// WithMiddleware adds middlewares that are run in the provided order on each HubSpot API request.
+// They're run after the [BearerTokenMiddleware] of the [Client].
+func WithMiddleware(m ...RequestMiddleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, m...)
+	}
+}
+
+// BearerTokenMiddleware authorizes requests with a provided access token.
+func BearerTokenMiddleware(accessToken string) RequestMiddleware {
+	return WithHeader("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+}
+
+// WithUserAgent sets a provided User-Agent header on requests.
+func WithUserAgent(ua string) RequestMiddleware {
+	return WithHeader("User-Agent", ua)
+}
+
+// WithHeader sets a header with a provided key and value on requests, replacing its existing values.
+func WithHeader(key, value string) RequestMiddleware {
+	return func(req *http.Request) error {
+		req.Header.Set(key, value)
+
+		return nil
+	}
+}
+
+// applyMiddlewares runs the Client's middlewares on a provided request.
+func (c *Client) applyMiddlewares(req *http.Request) error {
+	for _, middleware := range c.middlewares {
+		if err := middleware(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
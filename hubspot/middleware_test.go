@@ -0,0 +1,118 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_do_bearerToken(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/draft/publish", func(_ http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer secret"; got != want {
+			t.Errorf("expected Authorization header to be %q, but got %q", want, got)
+		}
+	})
+
+	if err := client.PublishHubDBTable(context.Background(), "42"); err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_WithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	var calls int
+
+	WithMiddleware(
+		WithUserAgent("conduit-connector-hubspot"),
+		WithHeader("X-Request-Source", "conduit"),
+		func(req *http.Request) error {
+			calls++
+
+			// middlewares are run in order, so they can see and mutate the headers set before them.
+			if got := req.Header.Get("X-Request-Source"); got != "conduit" {
+				t.Errorf("expected X-Request-Source header to be %q, but got %q", "conduit", got)
+			}
+
+			req.Header.Set("Authorization", "Bearer signed")
+
+			return nil
+		},
+	)(client)
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/draft/publish", func(_ http.ResponseWriter, r *http.Request) {
+		wantHeaders := map[string]string{
+			"User-Agent":       "conduit-connector-hubspot",
+			"X-Request-Source": "conduit",
+			"Authorization":    "Bearer signed",
+		}
+
+		for key, want := range wantHeaders {
+			if got := r.Header.Get(key); got != want {
+				t.Errorf("expected %s header to be %q, but got %q", key, want, got)
+			}
+		}
+	})
+
+	if err := client.PublishHubDBTable(context.Background(), "42"); err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected middleware to be called once, but got %d", calls)
+	}
+}
+
+func TestClient_WithMiddleware_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	errSign := errors.New("sign request")
+
+	WithMiddleware(func(*http.Request) error {
+		return errSign
+	})(client)
+
+	mux.HandleFunc("/cms/v3/hubdb/tables/42/draft/publish", func(http.ResponseWriter, *http.Request) {
+		t.Error("expected request not to be sent")
+	})
+
+	err := client.PublishHubDBTable(context.Background(), "42")
+	if !errors.Is(err, errSign) {
+		t.Errorf("expected error to be %v, but got %v", errSign, err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build otel
+
+// Package otel provides an OpenTelemetry implementation of the [hubspot.Tracer].
+// The package is built only with the otel build tag, so that the connector
+// doesn't depend on OpenTelemetry unless it's needed.
+package otel
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// statusCodeAttributeKey is a span attribute key for a response's status code.
+const statusCodeAttributeKey = "http.response.status_code"
+
+// Tracer is an OpenTelemetry implementation of the [hubspot.Tracer].
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracer creates a new instance of the [Tracer].
+// The trace context is propagated via the traceparent HTTP header.
+func NewTracer(tracer trace.Tracer) *Tracer {
+	return &Tracer{
+		tracer:     tracer,
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// WithTracer sets an OpenTelemetry tracer that is used to trace HubSpot API requests.
+func WithTracer(tracer trace.Tracer) hubspot.ClientOption {
+	return hubspot.WithTracer(NewTracer(tracer))
+}
+
+// Start starts a new client span as a child of the span within the context.
+func (t *Tracer) Start(ctx context.Context, spanName string) (context.Context, hubspot.Span) {
+	ctx, span := t.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient))
+
+	return ctx, &Span{span: span}
+}
+
+// Inject injects the trace context of the ctx into the HTTP headers.
+func (t *Tracer) Inject(ctx context.Context, header http.Header) {
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Span is an OpenTelemetry implementation of the [hubspot.Span].
+type Span struct {
+	span trace.Span
+}
+
+// SetStatusCode records the response's status code as a span attribute.
+func (s *Span) SetStatusCode(statusCode int) {
+	s.span.SetAttributes(attribute.Int(statusCodeAttributeKey, statusCode))
+}
+
+// RecordError records the error and sets the span's status to error.
+func (s *Span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End ends the span.
+func (s *Span) End() {
+	s.span.End()
+}
@@ -0,0 +1,94 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build otel
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracer(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") == "" {
+			t.Errorf("expected traceparent header to be set")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client := hubspot.NewClient("secret", &http.Client{
+		Transport: &testServerTransport{serverURL: server.URL},
+	}, WithTracer(provider.Tracer("test")))
+
+	if err := client.Delete(context.Background(), "crm.contacts", "1"); err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, but got %d", len(spans))
+	}
+
+	if spans[0].Name() != "hubspot.DELETE /crm/v3/objects/contacts/1" {
+		t.Errorf("expected span name to be %q, but got %q", "hubspot.DELETE /crm/v3/objects/contacts/1", spans[0].Name())
+	}
+
+	want := attribute.Int(statusCodeAttributeKey, http.StatusNoContent)
+	for _, attr := range spans[0].Attributes() {
+		if attr == want {
+			return
+		}
+	}
+
+	t.Errorf("expected span attributes to contain %v, but got %v", want, spans[0].Attributes())
+}
+
+// testServerTransport is an [http.RoundTripper] that sends all requests to a test server.
+type testServerTransport struct {
+	serverURL string
+}
+
+// RoundTrip replaces a request's scheme and host with the test server's ones.
+func (rt *testServerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	serverReq := req.Clone(req.Context())
+
+	serverURL, err := serverReq.URL.Parse(rt.serverURL)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // the error is returned as is in tests
+	}
+
+	serverReq.URL.Scheme = serverURL.Scheme
+	serverReq.URL.Host = serverURL.Host
+
+	return http.DefaultTransport.RoundTrip(serverReq)
+}
@@ -0,0 +1,95 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// SitePagesResource is a name of the CMS site pages resource.
+	SitePagesResource = "cms.pages.site"
+
+	// sitePagesPath is a path of the endpoint that lists site pages.
+	// https://developers.hubspot.com/docs/api/cms/pages
+	sitePagesPath = "/cms/v3/pages/site-pages"
+)
+
+// SitePageState is a publication state of a site page.
+type SitePageState string
+
+const (
+	// SitePageStateDraft is a state of site pages that have never been published.
+	SitePageStateDraft SitePageState = "DRAFT"
+	// SitePageStatePublished is a state of published site pages.
+	SitePageStatePublished SitePageState = "PUBLISHED"
+)
+
+// SitePageStates holds all the supported site page states.
+var SitePageStates = []SitePageState{
+	SitePageStateDraft,
+	SitePageStatePublished,
+}
+
+// SitePagesListOptions holds optional params for the [Client.ListSitePages] method.
+type SitePagesListOptions struct {
+	ListOptions
+	// State filters site pages by their publication state. If it's empty, pages of all states are returned.
+	State SitePageState `url:"state,omitempty"`
+}
+
+// ListSitePages returns a list of site pages, optionally filtered by their publication state.
+func (c *Client) ListSitePages(ctx context.Context, opts *SitePagesListOptions) (*ListResponse, error) {
+	return c.getList(ctx, sitePagesPath, opts)
+}
+
+// ResourcesPublishPaths holds a mapping of CMS page resources and their publish endpoints.
+var ResourcesPublishPaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/cms/pages
+	"cms.pages.landing": "/cms/v3/pages/landing-pages/{objectId}/schedule",
+	"cms.pages.site":    "/cms/v3/pages/site-pages/{objectId}/schedule",
+}
+
+// publishPageRequest is a request body of the page publish endpoints.
+type publishPageRequest struct {
+	PublishImmediately bool `json:"publishImmediately"`
+}
+
+// PublishPage publishes the draft of a CMS page immediately.
+// The method raises an *[UnsupportedResourceError] if a provided resource is not a CMS page resource.
+func (c *Client) PublishPage(ctx context.Context, resource, pageID string) error {
+	resourcePath, ok := ResourcesPublishPaths[resource]
+	if !ok {
+		return &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	resourcePath = strings.ReplaceAll(resourcePath, objectIDPlaceholder, pageID)
+
+	req, err := c.newRequest(ctx, http.MethodPost, resourcePath, publishPageRequest{PublishImmediately: true})
+	if err != nil {
+		return fmt.Errorf("create new request: %w", err)
+	}
+
+	if err := c.do(req, nil, nil); err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+
+	return nil
+}
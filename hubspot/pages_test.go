@@ -0,0 +1,188 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_PublishPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		resource string
+		path     string
+	}{
+		{
+			name:     "landing_page",
+			resource: "cms.pages.landing",
+			path:     "/cms/v3/pages/landing-pages/42/schedule",
+		},
+		{
+			name:     "site_page",
+			resource: "cms.pages.site",
+			path:     "/cms/v3/pages/site-pages/42/schedule",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+				}
+
+				var body map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("expected error to be nil, but got %v", err)
+				}
+
+				if body["publishImmediately"] != true {
+					t.Errorf("expected publishImmediately to be true, but got %v", body["publishImmediately"])
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			err := client.PublishPage(context.Background(), tt.resource, "42")
+			if err != nil {
+				t.Errorf("expected error to be nil, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_PublishPage_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	err := client.PublishPage(context.Background(), "crm.contacts", "42")
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_PublishPage_fail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/pages/site-pages/42/schedule", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.PublishPage(context.Background(), "cms.pages.site", "42")
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
+
+func TestClient_ListSitePages_state(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		state SitePageState
+	}{
+		{
+			name:  "draft",
+			state: SitePageStateDraft,
+		},
+		{
+			name:  "published",
+			state: SitePageStatePublished,
+		},
+		{
+			name:  "all_states",
+			state: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc("/cms/v3/pages/site-pages", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+				}
+
+				if r.URL.Query().Has("state") != (tt.state != "") {
+					t.Errorf("expected state to be present: %t, but got %q", tt.state != "", r.URL.Query().Get("state"))
+				}
+
+				if got := r.URL.Query().Get("state"); got != string(tt.state) {
+					t.Errorf("expected state to be %q, but got %q", tt.state, got)
+				}
+
+				if got := r.URL.Query().Get("limit"); got != "10" {
+					t.Errorf("expected limit to be %q, but got %q", "10", got)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(`{"total": 1, "results": [{"id": "1", "name": "About us"}]}`))
+				if err != nil {
+					t.Errorf("write body: %v", err)
+				}
+			})
+
+			got, err := client.ListSitePages(context.Background(), &SitePagesListOptions{
+				ListOptions: ListOptions{Limit: 10},
+				State:       tt.state,
+			})
+			if err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			if len(got.Results) != 1 {
+				t.Errorf("expected 1 result, but got %d", len(got.Results))
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// pipelinesPath is a path of the pipelines endpoint.
+// https://developers.hubspot.com/docs/api/crm/pipelines
+const pipelinesPath = "/crm/v3/pipelines/%s"
+
+// Pipeline is a CRM pipeline, such as a deal or ticket pipeline.
+type Pipeline struct {
+	ID           string          `json:"id"`
+	Label        string          `json:"label"`
+	DisplayOrder int             `json:"displayOrder"`
+	Archived     bool            `json:"archived"`
+	CreatedAt    *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time      `json:"updatedAt,omitempty"`
+	Stages       []PipelineStage `json:"stages"`
+}
+
+// PipelineStage is a stage of the [Pipeline].
+type PipelineStage struct {
+	ID           string         `json:"id"`
+	Label        string         `json:"label"`
+	DisplayOrder int            `json:"displayOrder"`
+	Archived     bool           `json:"archived"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	CreatedAt    *time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time     `json:"updatedAt,omitempty"`
+}
+
+// pipelinesResponse is a response of the pipelines endpoint.
+type pipelinesResponse struct {
+	Results []Pipeline `json:"results"`
+}
+
+// GetPipelines retrieves all pipelines of a specific object type, such as deals or tickets.
+func (c *Client) GetPipelines(ctx context.Context, objectType string) ([]Pipeline, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf(pipelinesPath, url.PathEscape(objectType)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp pipelinesResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// GetPipeline retrieves a single pipeline of a specific object type by its id.
+func (c *Client) GetPipeline(ctx context.Context, objectType, pipelineID string) (*Pipeline, error) {
+	resourcePath := fmt.Sprintf(pipelinesPath, url.PathEscape(objectType)) + "/" + url.PathEscape(pipelineID)
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp Pipeline
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,133 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testPipelineResponse is a deal pipeline with two stages.
+const testPipelineResponse = `{"id":"default","label":"Sales Pipeline","displayOrder":0,"archived":false,` +
+	`"createdAt":"2022-10-28T14:58:27Z","updatedAt":"2022-10-28T14:58:27Z","stages":[` +
+	`{"id":"appointmentscheduled","label":"Appointment Scheduled","displayOrder":0,"archived":false,` +
+	`"metadata":{"isClosed":"false","probability":"0.2"}},` +
+	`{"id":"closedwon","label":"Closed Won","displayOrder":1,"archived":false,` +
+	`"metadata":{"isClosed":"true","probability":"1.0"}}]}`
+
+// testPipeline is an expected decoded testPipelineResponse.
+func testPipeline() Pipeline {
+	createdAt := time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC)
+
+	return Pipeline{
+		ID:        "default",
+		Label:     "Sales Pipeline",
+		CreatedAt: &createdAt,
+		UpdatedAt: &createdAt,
+		Stages: []PipelineStage{
+			{
+				ID:       "appointmentscheduled",
+				Label:    "Appointment Scheduled",
+				Metadata: map[string]any{"isClosed": "false", "probability": "0.2"},
+			},
+			{
+				ID:           "closedwon",
+				Label:        "Closed Won",
+				DisplayOrder: 1,
+				Metadata:     map[string]any{"isClosed": "true", "probability": "1.0"},
+			},
+		},
+	}
+}
+
+func TestClient_GetPipelines_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/pipelines/deals", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		fmt.Fprintf(w, `{"results":[%s]}`, testPipelineResponse)
+	})
+
+	got, err := client.GetPipelines(context.Background(), "deals")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := []Pipeline{testPipeline()}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response = %+v, expected %+v", got, want)
+	}
+}
+
+func TestClient_GetPipeline_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/pipelines/deals/default", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, testPipelineResponse)
+	})
+
+	got, err := client.GetPipeline(context.Background(), "deals", "default")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := testPipeline()
+
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("Response = %+v, expected %+v", got, want)
+	}
+}
+
+func TestClient_GetPipeline_notFound(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/pipelines/deals/wrong", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetPipeline(context.Background(), "deals", "wrong")
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
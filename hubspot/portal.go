@@ -0,0 +1,49 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// portalInfoPath is a path of the endpoint that returns the metadata of the access token's portal.
+// https://legacydocs.hubspot.com/docs/methods/get-account-details
+const portalInfoPath = "/integrations/v1/me"
+
+// PortalInfo holds the metadata of a HubSpot account, also known as a portal.
+type PortalInfo struct {
+	PortalID  int    `json:"portalId"`
+	TimeZone  string `json:"timeZone"`
+	Currency  string `json:"currency"`
+	UTCOffset string `json:"utcOffset"`
+}
+
+// GetPortalInfo retrieves the metadata of the portal the access token belongs to.
+// Any access token that is valid and has at least one scope is allowed to call it.
+func (c *Client) GetPortalInfo(ctx context.Context) (*PortalInfo, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, portalInfoPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp PortalInfo
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
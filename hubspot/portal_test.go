@@ -0,0 +1,85 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetPortalInfo_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/integrations/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		fmt.Fprint(w, `{"portalId":62515,"timeZone":"US/Eastern","accountType":"STANDARD",`+
+			`"currency":"USD","utcOffset":"-05:00","utcOffsetMilliseconds":-18000000}`)
+	})
+
+	portalInfo, err := client.GetPortalInfo(context.Background())
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := &PortalInfo{
+		PortalID:  62515,
+		TimeZone:  "US/Eastern",
+		Currency:  "USD",
+		UTCOffset: "-05:00",
+	}
+
+	if !reflect.DeepEqual(portalInfo, expected) {
+		t.Errorf("PortalInfo = %+v, expected %+v", portalInfo, expected)
+	}
+}
+
+func TestClient_GetPortalInfo_unauthorized(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/integrations/v1/me", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"status":"error","message":"Authentication credentials not found."}`)
+	})
+
+	_, err := client.GetPortalInfo(context.Background())
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Fatalf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+
+	if unexpectedStatusCodeErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status code %d, but got %d", http.StatusUnauthorized, unexpectedStatusCodeErr.StatusCode)
+	}
+}
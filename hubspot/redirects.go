@@ -0,0 +1,49 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+)
+
+const (
+	// URLRedirectsResource is a name of the CMS URL redirects resource.
+	URLRedirectsResource = "cms.urlRedirects"
+
+	// urlRedirectsPath is a path of the endpoint that lists URL redirects.
+	// https://developers.hubspot.com/docs/api/cms/url-redirects
+	urlRedirectsPath = "/cms/v3/url-redirects"
+)
+
+// URLRedirectsSearchOptions holds query params of the [Client.SearchURLRedirects] method.
+type URLRedirectsSearchOptions struct {
+	ListOptions
+	// Query is a text the URL redirects are searched by.
+	Query string `url:"query,omitempty"`
+}
+
+// SearchURLRedirects returns a list of URL redirects that match a provided query.
+// If the query is empty, all URL redirects are returned.
+func (c *Client) SearchURLRedirects(ctx context.Context, query string, opts *ListOptions) (*ListResponse, error) {
+	searchOpts := &URLRedirectsSearchOptions{
+		Query: query,
+	}
+
+	if opts != nil {
+		searchOpts.ListOptions = *opts
+	}
+
+	return c.getList(ctx, urlRedirectsPath, searchOpts)
+}
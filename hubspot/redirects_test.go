@@ -0,0 +1,96 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_SearchURLRedirects_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/url-redirects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		if got, want := r.URL.RawQuery, "limit=10&query=%2Fblog+old"; got != want {
+			t.Errorf("expected query string to be %q, but got %q", want, got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"total": 1, "results": [{"id": "1", "routePrefix": "/blog old", ` +
+			`"destination": "/blog", "createdAt": "2022-10-28T14:58:27Z", "updatedAt": "2022-10-29T14:58:27Z"}]}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.SearchURLRedirects(context.Background(), "/blog old", &ListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total: 1,
+		Results: []ListResponseResult{{
+			"id":          "1",
+			"routePrefix": "/blog old",
+			"destination": "/blog",
+			"createdAt":   "2022-10-28T14:58:27Z",
+			"updatedAt":   "2022-10-29T14:58:27Z",
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_SearchURLRedirects_emptyQuery(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/cms/v3/url-redirects", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("query") {
+			t.Errorf("expected query to be omitted, but got %q", r.URL.Query().Get("query"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results": []}`))
+		if err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	_, err := client.SearchURLRedirects(context.Background(), "", nil)
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// objectSchemaPath is a path of the endpoint that returns a definition of a custom object type.
+// https://developers.hubspot.com/docs/api/crm/crm-custom-objects
+const objectSchemaPath = "/crm/v3/schemas/%s"
+
+// ResourcesSchemaPaths holds a mapping of supported resources and their properties endpoints.
+var ResourcesSchemaPaths = map[string]string{
+	// https://developers.hubspot.com/docs/api/crm/properties
+	"crm.companies":           "/crm/v3/properties/companies",
+	"crm.contacts":            "/crm/v3/properties/contacts",
+	"crm.deals":               "/crm/v3/properties/deals",
+	"crm.feedbackSubmissions": "/crm/v3/properties/feedback_submissions",
+	"crm.lineItems":           "/crm/v3/properties/line_items",
+	"crm.products":            "/crm/v3/properties/products",
+	"crm.tickets":             "/crm/v3/properties/tickets",
+	"crm.quotes":              "/crm/v3/properties/quotes",
+	"crm.calls":               "/crm/v3/properties/calls",
+	"crm.emails":              "/crm/v3/properties/emails",
+	"crm.meetings":            "/crm/v3/properties/meetings",
+	"crm.notes":               "/crm/v3/properties/notes",
+	"crm.tasks":               "/crm/v3/properties/tasks",
+	"crm.postalMail":          "/crm/v3/properties/postal_mail",
+	"crm.taskQueues":          "/crm/v3/properties/task_queues",
+}
+
+// ObjectSchema is a schema definition of a CRM object.
+type ObjectSchema struct {
+	Name         string                  `json:"name"`
+	Properties   []PropertyDefinition    `json:"properties"`
+	Associations []AssociationDefinition `json:"associations"`
+}
+
+// PropertyDefinition is a definition of a single CRM object property.
+type PropertyDefinition struct {
+	Name           string                     `json:"name"`
+	Label          string                     `json:"label"`
+	Type           string                     `json:"type"`
+	FieldType      string                     `json:"fieldType"`
+	Description    string                     `json:"description"`
+	GroupName      string                     `json:"groupName"`
+	Options        []PropertyDefinitionOption `json:"options"`
+	Calculated     bool                       `json:"calculated"`
+	HasUniqueValue bool                       `json:"hasUniqueValue"`
+	Hidden         bool                       `json:"hidden"`
+}
+
+// PropertyDefinitionOption is an option of an enumeration property.
+type PropertyDefinitionOption struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// AssociationDefinition is a definition of an association between two CRM objects.
+type AssociationDefinition struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	FromObjectTypeID string `json:"fromObjectTypeId"`
+	ToObjectTypeID   string `json:"toObjectTypeId"`
+}
+
+// ObjectTypeDefinition is a definition of a custom CRM object type.
+type ObjectTypeDefinition struct {
+	ID                     string                  `json:"id"`
+	Name                   string                  `json:"name"`
+	ObjectTypeID           string                  `json:"objectTypeId"`
+	FullyQualifiedName     string                  `json:"fullyQualifiedName"`
+	PrimaryDisplayProperty string                  `json:"primaryDisplayProperty"`
+	Properties             []PropertyDefinition    `json:"properties"`
+	Associations           []AssociationDefinition `json:"associations"`
+}
+
+// SearchResource returns a [SearchResource] of the object type, so that its objects can be searched
+// like the ones of standard CRM resources. Custom objects are sorted and filtered by the hs_ properties
+// that HubSpot sets on all of them.
+func (d *ObjectTypeDefinition) SearchResource() SearchResource {
+	return SearchResource{
+		Path:               fmt.Sprintf("/crm/v3/objects/%s/search", url.PathEscape(d.ObjectTypeID)),
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+		CreatedAtSortName:  "hs_createdate",
+		UpdatedAtSortName:  "hs_lastmodifieddate",
+		ObjectIDFilterName: "hs_object_id",
+	}
+}
+
+// propertiesResponse is a response of the properties endpoint.
+type propertiesResponse struct {
+	Results []PropertyDefinition `json:"results"`
+}
+
+// GetSchema retrieves a schema definition of a specific resource.
+// Standard CRM objects expose only their properties, so the Associations of the returned schema are empty.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) GetSchema(ctx context.Context, resource string) (*ObjectSchema, error) {
+	properties, err := c.GetProperties(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectSchema{
+		Name:       resource,
+		Properties: properties,
+	}, nil
+}
+
+// GetProperties retrieves definitions of all the properties of a specific resource.
+// The method raises an *[UnsupportedResourceError] if a provided resource is unsupported.
+func (c *Client) GetProperties(ctx context.Context, resource string) ([]PropertyDefinition, error) {
+	resourcePath, ok := ResourcesSchemaPaths[resource]
+	if !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, resourcePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp propertiesResponse
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return resp.Results, nil
+}
+
+// GetObjectSchema retrieves a definition of a custom object type by its id, e.g. 2-123456,
+// or by its fully qualified name, e.g. p_cars.
+func (c *Client) GetObjectSchema(ctx context.Context, objectTypeID string) (*ObjectTypeDefinition, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf(objectSchemaPath, url.PathEscape(objectTypeID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp ObjectTypeDefinition
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,264 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetSchema_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/properties/contacts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		fmt.Fprint(w, `{"results":[
+			{"name":"email","label":"Email","type":"string","fieldType":"text","groupName":"contactinformation",
+			"hasUniqueValue":true},
+			{"name":"lifecyclestage","label":"Lifecycle Stage","type":"enumeration","fieldType":"radio",
+			"groupName":"contactinformation","options":[{"label":"Lead","value":"lead"}]}
+		]}`)
+	})
+
+	schema, err := client.GetSchema(context.Background(), "crm.contacts")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := &ObjectSchema{
+		Name: "crm.contacts",
+		Properties: []PropertyDefinition{
+			{
+				Name:           "email",
+				Label:          "Email",
+				Type:           "string",
+				FieldType:      "text",
+				GroupName:      "contactinformation",
+				HasUniqueValue: true,
+			},
+			{
+				Name:      "lifecyclestage",
+				Label:     "Lifecycle Stage",
+				Type:      "enumeration",
+				FieldType: "radio",
+				GroupName: "contactinformation",
+				Options:   []PropertyDefinitionOption{{Label: "Lead", Value: "lead"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(schema, expected) {
+		t.Errorf("expected schema to be %+v, but got %+v", expected, schema)
+	}
+}
+
+func TestClient_GetSchema_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	_, err := client.GetSchema(context.Background(), "cms.blogs.authors")
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+
+	var unsupportedResourceEerr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceEerr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_GetSchema_unexpectedStatusCode(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/properties/contacts", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetSchema(context.Background(), "crm.contacts")
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
+
+func TestClient_GetProperties_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/properties/deals", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"results":[{"name":"dealname","label":"Deal Name"},{"name":"amount","label":"Amount"}]}`)
+	})
+
+	properties, err := client.GetProperties(context.Background(), "crm.deals")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := []PropertyDefinition{
+		{Name: "dealname", Label: "Deal Name"},
+		{Name: "amount", Label: "Amount"},
+	}
+
+	if !reflect.DeepEqual(properties, expected) {
+		t.Errorf("expected properties to be %v, but got %v", expected, properties)
+	}
+}
+
+func TestClient_GetObjectSchema_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/schemas/2-123456", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected method to be %s, but got %s", http.MethodGet, r.Method)
+		}
+
+		fmt.Fprint(w, `{
+			"id":"123456",
+			"name":"cars",
+			"objectTypeId":"2-123456",
+			"fullyQualifiedName":"p_cars",
+			"primaryDisplayProperty":"model",
+			"labels":{"singular":"Car","plural":"Cars"},
+			"requiredProperties":["model"],
+			"properties":[
+				{"name":"model","label":"Model","type":"string","fieldType":"text","groupName":"car_information",
+				"hasUniqueValue":false},
+				{"name":"year","label":"Year","type":"number","fieldType":"number","groupName":"car_information"}
+			],
+			"associations":[
+				{"id":"105","name":"cars_to_contacts","fromObjectTypeId":"2-123456","toObjectTypeId":"0-1"}
+			],
+			"createdAt":"2022-10-27T13:00:00Z",
+			"updatedAt":"2022-10-28T13:00:00Z"
+		}`)
+	})
+
+	schema, err := client.GetObjectSchema(context.Background(), "2-123456")
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := &ObjectTypeDefinition{
+		ID:                     "123456",
+		Name:                   "cars",
+		ObjectTypeID:           "2-123456",
+		FullyQualifiedName:     "p_cars",
+		PrimaryDisplayProperty: "model",
+		Properties: []PropertyDefinition{
+			{
+				Name:      "model",
+				Label:     "Model",
+				Type:      "string",
+				FieldType: "text",
+				GroupName: "car_information",
+			},
+			{
+				Name:      "year",
+				Label:     "Year",
+				Type:      "number",
+				FieldType: "number",
+				GroupName: "car_information",
+			},
+		},
+		Associations: []AssociationDefinition{
+			{
+				ID:               "105",
+				Name:             "cars_to_contacts",
+				FromObjectTypeID: "2-123456",
+				ToObjectTypeID:   "0-1",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(schema, expected) {
+		t.Errorf("expected schema to be %+v, but got %+v", expected, schema)
+	}
+
+	expectedSearchResource := SearchResource{
+		Path:               "/crm/v3/objects/2-123456/search",
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+		CreatedAtSortName:  "hs_createdate",
+		UpdatedAtSortName:  "hs_lastmodifieddate",
+		ObjectIDFilterName: "hs_object_id",
+	}
+
+	if searchResource := schema.SearchResource(); searchResource != expectedSearchResource {
+		t.Errorf("expected search resource to be %+v, but got %+v", expectedSearchResource, searchResource)
+	}
+}
+
+func TestClient_GetObjectSchema_unexpectedStatusCode(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/schemas/p_cars", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetObjectSchema(context.Background(), "p_cars")
+	if err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// tokenInfoPath is a path of the endpoint that returns the metadata of a private app access token.
+// https://developers.hubspot.com/docs/api/oauth/tokens
+const tokenInfoPath = "/oauth/v2/private-apps/get/access-token-info"
+
+// HubSpot scopes required by the supported resources.
+const (
+	scopeContent            = "content"
+	scopeHubDB              = "hubdb"
+	scopeForms              = "forms"
+	scopeTickets            = "tickets"
+	scopeECommerce          = "e-commerce"
+	scopeAutomation         = "automation"
+	scopeContactsRead       = "crm.objects.contacts.read"
+	scopeContactsWrite      = "crm.objects.contacts.write"
+	scopeCompaniesRead      = "crm.objects.companies.read"
+	scopeCompaniesWrite     = "crm.objects.companies.write"
+	scopeDealsRead          = "crm.objects.deals.read"
+	scopeDealsWrite         = "crm.objects.deals.write"
+	scopeLineItemsRead      = "crm.objects.line_items.read"
+	scopeLineItemsWrite     = "crm.objects.line_items.write"
+	scopeQuotesRead         = "crm.objects.quotes.read"
+	scopeQuotesWrite        = "crm.objects.quotes.write"
+	scopeFeedbackRead       = "crm.objects.feedback_submissions.read"
+	scopeConversationsRead  = "conversations.read"
+	scopeConversationsWrite = "conversations.write"
+)
+
+// resourceScopes holds the scopes a resource requires to be read and written.
+type resourceScopes struct {
+	read  []string
+	write []string
+}
+
+// scopesByResource maps the supported resources to their required scopes.
+var scopesByResource = map[string]resourceScopes{
+	"cms.blogs.authors":          {read: []string{scopeContent}, write: []string{scopeContent}},
+	BlogPostsResource:            {read: []string{scopeContent}, write: []string{scopeContent}},
+	"cms.blogs.tags":             {read: []string{scopeContent}, write: []string{scopeContent}},
+	"cms.pages.landing":          {read: []string{scopeContent}, write: []string{scopeContent}},
+	SitePagesResource:            {read: []string{scopeContent}, write: []string{scopeContent}},
+	URLRedirectsResource:         {read: []string{scopeContent}, write: []string{scopeContent}},
+	"cms.domains":                {read: []string{scopeContent}},
+	"cms.hubdb.tables":           {read: []string{scopeHubDB}, write: []string{scopeHubDB}},
+	"cms.hubdb.rows":             {read: []string{scopeHubDB}, write: []string{scopeHubDB}},
+	"cms.forms":                  {read: []string{scopeForms}, write: []string{scopeForms}},
+	"marketing.emails":           {read: []string{scopeContent}, write: []string{scopeContent}},
+	ContactsResource:             {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.companies":              {read: []string{scopeCompaniesRead}, write: []string{scopeCompaniesWrite}},
+	"crm.deals":                  {read: []string{scopeDealsRead}, write: []string{scopeDealsWrite}},
+	"crm.lineItems":              {read: []string{scopeLineItemsRead}, write: []string{scopeLineItemsWrite}},
+	"crm.quotes":                 {read: []string{scopeQuotesRead}, write: []string{scopeQuotesWrite}},
+	"crm.feedbackSubmissions":    {read: []string{scopeFeedbackRead}},
+	"crm.products":               {read: []string{scopeECommerce}, write: []string{scopeECommerce}},
+	"crm.tickets":                {read: []string{scopeTickets}, write: []string{scopeTickets}},
+	CallsResource:                {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.emails":                 {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.meetings":               {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.notes":                  {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.tasks":                  {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.postalMail":             {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	"crm.taskQueues":             {read: []string{scopeContactsRead}, write: []string{scopeContactsWrite}},
+	ConversationMessagesResource: {read: []string{scopeConversationsRead}, write: []string{scopeConversationsWrite}},
+	WorkflowsResource:            {read: []string{scopeAutomation}, write: []string{scopeAutomation}},
+}
+
+// TokenInfo holds the metadata of an access token.
+type TokenInfo struct {
+	ScopesGranted []string `json:"scopes"`
+	PortalID      int      `json:"hubId"`
+}
+
+// tokenInfoRequest is a request body of the token info endpoint.
+type tokenInfoRequest struct {
+	TokenKey string `json:"tokenKey"`
+}
+
+// WhoAmI retrieves the metadata of the private app access token the Client was created with,
+// including the scopes it's granted.
+func (c *Client) WhoAmI(ctx context.Context) (*TokenInfo, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, tokenInfoPath, tokenInfoRequest{TokenKey: c.accessToken})
+	if err != nil {
+		return nil, fmt.Errorf("create new request: %w", err)
+	}
+
+	var resp TokenInfo
+	if err := c.do(req, &resp, nil); err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// RequiredScopes returns the HubSpot scopes an access token needs to perform the operation on the resource.
+// The list and search operations require the read scopes, the create, update and delete operations require
+// the write scopes. It returns nil if the resource or the operation is unknown.
+func RequiredScopes(resource, operation string) []string {
+	scopes, ok := scopesByResource[resource]
+	if !ok {
+		return nil
+	}
+
+	switch operation {
+	case "list", "search":
+		return scopes.read
+	case "create", "update", "delete":
+		return scopes.write
+	default:
+		return nil
+	}
+}
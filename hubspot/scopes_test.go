@@ -0,0 +1,169 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_WhoAmI_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc(tokenInfoPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPost, r.Method)
+		}
+
+		var body tokenInfoRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		if body.TokenKey != "secret" {
+			t.Errorf("expected token key to be %q, but got %q", "secret", body.TokenKey)
+		}
+
+		fmt.Fprint(w, `{"userId":123123,"hubId":62515,"appId":456456,`+
+			`"scopes":["oauth","crm.objects.contacts.read","crm.objects.contacts.write"]}`)
+	})
+
+	tokenInfo, err := client.WhoAmI(context.Background())
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	expected := &TokenInfo{
+		ScopesGranted: []string{"oauth", "crm.objects.contacts.read", "crm.objects.contacts.write"},
+		PortalID:      62515,
+	}
+
+	if !reflect.DeepEqual(tokenInfo, expected) {
+		t.Errorf("TokenInfo = %+v, expected %+v", tokenInfo, expected)
+	}
+}
+
+func TestClient_WhoAmI_unauthorized(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc(tokenInfoPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"status":"error","message":"The access token is invalid."}`)
+	})
+
+	_, err := client.WhoAmI(context.Background())
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Fatalf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+
+	if unexpectedStatusCodeErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status code %d, but got %d", http.StatusUnauthorized, unexpectedStatusCodeErr.StatusCode)
+	}
+}
+
+func TestRequiredScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resource  string
+		operation string
+		want      []string
+	}{
+		{
+			name:      "contacts_list",
+			resource:  ContactsResource,
+			operation: "list",
+			want:      []string{"crm.objects.contacts.read"},
+		},
+		{
+			name:      "contacts_create",
+			resource:  ContactsResource,
+			operation: "create",
+			want:      []string{"crm.objects.contacts.write"},
+		},
+		{
+			name:      "calls_search",
+			resource:  CallsResource,
+			operation: "search",
+			want:      []string{"crm.objects.contacts.read"},
+		},
+		{
+			name:      "hubdb_rows_delete",
+			resource:  "cms.hubdb.rows",
+			operation: "delete",
+			want:      []string{"hubdb"},
+		},
+		{
+			name:      "feedback_submissions_update",
+			resource:  "crm.feedbackSubmissions",
+			operation: "update",
+			want:      nil,
+		},
+		{
+			name:      "unknown_resource",
+			resource:  "crm.unknown",
+			operation: "list",
+			want:      nil,
+		},
+		{
+			name:      "unknown_operation",
+			resource:  ContactsResource,
+			operation: "merge",
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := RequiredScopes(tt.resource, tt.operation); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RequiredScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredScopes_listResources(t *testing.T) {
+	t.Parallel()
+
+	for resource := range ResourcesListPaths {
+		if len(RequiredScopes(resource, "list")) == 0 {
+			t.Errorf("expected resource %q to require scopes", resource)
+		}
+	}
+}
@@ -163,6 +163,24 @@ var SearchResources = map[string]SearchResource{
 		UpdatedAtSortName:  "hs_lastmodifieddate",
 		ObjectIDFilterName: "hs_object_id",
 	},
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": {
+		Path:               "/crm/v3/objects/postal_mail/search",
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+		CreatedAtSortName:  "hs_createdate",
+		UpdatedAtSortName:  "hs_lastmodifieddate",
+		ObjectIDFilterName: "hs_object_id",
+	},
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": {
+		Path:               "/crm/v3/objects/task_queues/search",
+		CreatedAtFieldName: "createdAt",
+		UpdatedAtFieldName: "updatedAt",
+		CreatedAtSortName:  "hs_createdate",
+		UpdatedAtSortName:  "hs_lastmodifieddate",
+		ObjectIDFilterName: "hs_object_id",
+	},
 }
 
 // SearchRequest is a request model for the [Search] method.
@@ -171,6 +189,7 @@ type SearchRequest struct {
 	Properties   []string                   `json:"properties,omitempty"`
 	FilterGroups []SearchRequestFilterGroup `json:"filterGroups,omitempty"`
 	Sorts        []SearchRequestSort        `json:"sorts,omitempty"`
+	After        string                     `json:"after,omitempty"`
 }
 
 // SearchRequestFilterGroup is a fiterGroup object for the [SearchRequest].
@@ -206,14 +225,128 @@ func (c *Client) Search(ctx context.Context, resource string, request *SearchReq
 	}
 
 	var resp ListResponse
-	if err := c.do(req, &resp); err != nil {
+	if err := c.do(req, &resp, nil); err != nil {
 		return nil, fmt.Errorf("execute request: %w", err)
 	}
 
 	return &resp, nil
 }
 
-// SearchByUpdatedAfter is a wrapper that calls the [Search] method returning only those results
+// SearchWithPagination starts a goroutine that pages through all the results of the provided search request
+// and sends them one by one to the returned results channel. The results channel is closed when all the pages
+// are processed, the context is canceled, or the search fails. In the latter case the error is sent
+// to the returned error channel before the results channel is closed. The error channel is closed after that.
+// The caller controls the back-pressure via the speed of receiving from the results channel.
+func (c *Client) SearchWithPagination(
+	ctx context.Context,
+	resource string,
+	request *SearchRequest,
+) (<-chan ListResponseResult, <-chan error) {
+	resultsC := make(chan ListResponseResult)
+	errC := make(chan error, 1)
+
+	go func() {
+		defer close(errC)
+		defer close(resultsC)
+
+		// copy the request so as not to modify the caller's one when moving the cursor
+		pageRequest := *request
+
+		for {
+			listResponse, err := c.Search(ctx, resource, &pageRequest)
+			if err != nil {
+				errC <- err
+
+				return
+			}
+
+			for _, result := range listResponse.Results {
+				select {
+				case <-ctx.Done():
+					errC <- ctx.Err()
+
+					return
+
+				case resultsC <- result:
+				}
+			}
+
+			if !listResponse.HasMore() {
+				return
+			}
+
+			pageRequest.After = listResponse.NextAfter()
+		}
+	}()
+
+	return resultsC, errC
+}
+
+// SearchOption is a functional option that configures the request of the [Client.SearchByMultipleFields].
+type SearchOption func(*SearchRequest)
+
+// WithSearchProperties sets the properties that are returned in addition to the default ones.
+func WithSearchProperties(properties []string) SearchOption {
+	return func(req *SearchRequest) {
+		req.Properties = properties
+	}
+}
+
+// WithSearchAfter sets the cursor returned in the paging of the previous page,
+// if it's empty the first page is returned.
+func WithSearchAfter(after string) SearchOption {
+	return func(req *SearchRequest) {
+		req.After = after
+	}
+}
+
+// withSecondarySort adds an ascending sort by the object id field, see the [addSecondarySort].
+func withSecondarySort(objectIDField string) SearchOption {
+	return func(req *SearchRequest) {
+		addSecondarySort(req, objectIDField)
+	}
+}
+
+// SearchByMultipleFields is a wrapper that calls the [Search] method returning only those results
+// that match all the filters and ordering them by the sorts.
+// If the limit is zero, HubSpot's default page size is used.
+func (c *Client) SearchByMultipleFields(
+	ctx context.Context,
+	resource string,
+	filters []SearchRequestFilterGroupFilter,
+	sorts []SearchRequestSort,
+	limit int,
+	opts ...SearchOption,
+) (*ListResponse, error) {
+	if _, ok := SearchResources[resource]; !ok {
+		return nil, &UnsupportedResourceError{
+			Resource: resource,
+		}
+	}
+
+	req := &SearchRequest{
+		Sorts: sorts,
+	}
+
+	// filters of a single group are combined with AND.
+	if len(filters) > 0 {
+		req.FilterGroups = []SearchRequestFilterGroup{{Filters: filters}}
+	}
+
+	// we'll use the limit if it's not zero
+	// by default HubSpot set this value to 100
+	if limit != 0 {
+		req.Limit = strconv.Itoa(limit)
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	return c.Search(ctx, resource, req)
+}
+
+// SearchByUpdatedAfter is a wrapper that calls the [SearchByMultipleFields] method returning only those results
 // that were updated after a specific date and ordering them ascendingly by updatedAt field.
 func (c *Client) SearchByUpdatedAfter(
 	ctx context.Context,
@@ -229,37 +362,33 @@ func (c *Client) SearchByUpdatedAfter(
 		}
 	}
 
-	return c.Search(ctx, resource, &SearchRequest{
-		Limit:      strconv.Itoa(limit),
-		Properties: properties,
-		FilterGroups: []SearchRequestFilterGroup{
-			{
-				Filters: []SearchRequestFilterGroupFilter{
-					{
-						PropertyName: searchResource.UpdatedAtSortName,
-						Operator:     GTEOperator,
-						Value:        strconv.Itoa(int(updatedAfter.UnixMilli())),
-					},
-				},
-			},
-		},
-		Sorts: []SearchRequestSort{
-			{
-				PropertyName: searchResource.UpdatedAtSortName,
-				Direction:    ASCSortDirection,
-			},
-		},
-	})
+	return c.SearchByMultipleFields(ctx, resource,
+		[]SearchRequestFilterGroupFilter{{
+			PropertyName: searchResource.UpdatedAtSortName,
+			Operator:     GTEOperator,
+			Value:        strconv.Itoa(int(updatedAfter.UnixMilli())),
+		}},
+		[]SearchRequestSort{{
+			PropertyName: searchResource.UpdatedAtSortName,
+			Direction:    ASCSortDirection,
+		}},
+		limit,
+		WithSearchProperties(properties),
+	)
 }
 
-// SearchByCreatedBefore is a wrapper that calls the [Search] method returning only those results
-// that were created before a specific date and ordering them ascendingly by createdAt field.
+// SearchByCreatedBefore is a wrapper that calls the [SearchByMultipleFields] method returning only those results
+// that were created before a specific date and ordering them ascendingly by the orderBy property.
+// If the orderBy is empty, the results are ordered by createdAt field.
+// The after is a cursor returned in the paging of the previous page, if it's empty the first page is returned.
 func (c *Client) SearchByCreatedBefore(
 	ctx context.Context,
 	resource string,
 	createdBefore time.Time,
-	limit, after int,
+	limit int,
+	after string,
 	properties []string,
+	orderBy string,
 ) (*ListResponse, error) {
 	searchResource, ok := SearchResources[resource]
 	if !ok {
@@ -268,44 +397,40 @@ func (c *Client) SearchByCreatedBefore(
 		}
 	}
 
-	// create filters based on provided arguments
-	filters := []SearchRequestFilterGroupFilter{
-		{
+	if orderBy == "" {
+		orderBy = searchResource.CreatedAtSortName
+	}
+
+	return c.SearchByMultipleFields(ctx, resource,
+		[]SearchRequestFilterGroupFilter{{
 			PropertyName: searchResource.CreatedAtSortName,
 			Operator:     LTEOperator,
 			Value:        strconv.Itoa(int(createdBefore.UnixMilli())),
-		},
-	}
-
-	if after != 0 {
-		filters = append(filters, SearchRequestFilterGroupFilter{
-			PropertyName: searchResource.ObjectIDFilterName,
-			Operator:     GTEOperator,
-			Value:        strconv.Itoa(after),
-		})
-	}
-
-	// construct request body with the created filters and sorting
-	req := &SearchRequest{
-		Properties: properties,
-		FilterGroups: []SearchRequestFilterGroup{
-			{
-				Filters: filters,
-			},
-		},
-		Sorts: []SearchRequestSort{
-			{
-				PropertyName: searchResource.CreatedAtSortName,
-				Direction:    ASCSortDirection,
-			},
-		},
-	}
+		}},
+		[]SearchRequestSort{{
+			PropertyName: orderBy,
+			Direction:    ASCSortDirection,
+		}},
+		limit,
+		WithSearchProperties(properties),
+		WithSearchAfter(after),
+		// items with the same orderBy value are sorted by their ids,
+		// so that page boundaries are deterministic.
+		withSecondarySort(searchResource.ObjectIDFilterName),
+	)
+}
 
-	// we'll use the limit if it's not zero
-	// by default HubSpot set this value to 100
-	if limit != 0 {
-		req.Limit = strconv.Itoa(limit)
+// addSecondarySort adds an ascending sort by the object id field to the request,
+// unless the request is already sorted by it.
+func addSecondarySort(req *SearchRequest, objectIDField string) {
+	for _, sort := range req.Sorts {
+		if sort.PropertyName == objectIDField {
+			return
+		}
 	}
 
-	return c.Search(ctx, resource, req)
+	req.Sorts = append(req.Sorts, SearchRequestSort{
+		PropertyName: objectIDField,
+		Direction:    ASCSortDirection,
+	})
 }
@@ -16,11 +16,14 @@ package hubspot
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestClient_Search_success(t *testing.T) {
@@ -106,3 +109,384 @@ func TestClient_Search_unsupportedResource(t *testing.T) {
 		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
 	}
 }
+
+func TestClient_SearchWithPagination_success(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	pages := map[string]string{
+		"":  `{"total":5,"results": [{"id": "1"}, {"id": "2"}], "paging": {"next": {"after": "2"}}}`,
+		"2": `{"total":5,"results": [{"id": "3"}, {"id": "4"}], "paging": {"next": {"after": "4"}}}`,
+		"4": `{"total":5,"results": [{"id": "5"}]}`,
+	}
+
+	mux.HandleFunc("/crm/v3/objects/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		page, ok := pages[req.After]
+		if !ok {
+			t.Errorf("unexpected after cursor %q", req.After)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(page)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	request := &SearchRequest{Limit: "2"}
+
+	resultsC, errC := client.SearchWithPagination(ctx, "crm.contacts", request)
+
+	var got []ListResponseResult
+	for result := range resultsC {
+		got = append(got, result)
+	}
+
+	if err := <-errC; err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+
+	want := []ListResponseResult{{"id": "1"}, {"id": "2"}, {"id": "3"}, {"id": "4"}, {"id": "5"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("results = %v, expected %v", got, want)
+	}
+
+	if request.After != "" {
+		t.Errorf("expected the request not to be modified, but got after %q", request.After)
+	}
+}
+
+func TestClient_SearchWithPagination_fail(t *testing.T) {
+	t.Parallel()
+
+	client, _, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	resultsC, errC := client.SearchWithPagination(context.Background(), "wrong", &SearchRequest{})
+
+	for result := range resultsC {
+		t.Errorf("expected no results, but got %v", result)
+	}
+
+	var unsupportedResourceEerr *UnsupportedResourceError
+	if err := <-errC; !errors.As(err, &unsupportedResourceEerr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
+
+func TestClient_SearchByCreatedBefore_secondarySort(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		want := []SearchRequestSort{
+			{PropertyName: "createdate", Direction: ASCSortDirection},
+			{PropertyName: "hs_object_id", Direction: ASCSortDirection},
+		}
+
+		if !reflect.DeepEqual(req.Sorts, want) {
+			t.Errorf("expected sorts to be %v, but got %v", want, req.Sorts)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"total":0,"results":[]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	_, err := client.SearchByCreatedBefore(context.Background(), "crm.contacts", time.Now(), 10, "", nil, "")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_SearchByCreatedBefore_after(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		if req.After != "100" {
+			t.Errorf("expected after to be %q, but got %q", "100", req.After)
+		}
+
+		// the cursor is passed as is, without filtering items by their ids.
+		if len(req.FilterGroups) != 1 || len(req.FilterGroups[0].Filters) != 1 {
+			t.Errorf("expected a single createdBefore filter, but got %v", req.FilterGroups)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"total":0,"results":[]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	_, err := client.SearchByCreatedBefore(context.Background(), "crm.contacts", time.Now(), 10, "100", nil, "")
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestAddSecondarySort(t *testing.T) {
+	t.Parallel()
+
+	req := &SearchRequest{
+		Sorts: []SearchRequestSort{{PropertyName: "hs_object_id", Direction: ASCSortDirection}},
+	}
+
+	addSecondarySort(req, "hs_object_id")
+
+	// the request is already sorted by the object id, so the sort isn't duplicated.
+	if len(req.Sorts) != 1 {
+		t.Errorf("expected one sort, but got %v", req.Sorts)
+	}
+}
+
+func TestClient_SearchByUpdatedAfter_crmPostalMail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/postal_mail/search", func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		if len(req.Sorts) == 0 || req.Sorts[0].PropertyName != "hs_lastmodifieddate" {
+			t.Errorf("expected items to be sorted by %q, but got %v", "hs_lastmodifieddate", req.Sorts)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"total":1,"results":[{"id":"1","updatedAt":"2022-10-28T14:58:27Z"}]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.SearchByUpdatedAfter(context.Background(), "crm.postalMail", time.Now(), 10, nil)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total:   1,
+		Results: []ListResponseResult{{"id": "1", "updatedAt": "2022-10-28T14:58:27Z"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_SearchByUpdatedAfter_crmTaskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues/search", func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		if len(req.Sorts) == 0 || req.Sorts[0].PropertyName != "hs_lastmodifieddate" {
+			t.Errorf("expected items to be sorted by %q, but got %v", "hs_lastmodifieddate", req.Sorts)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"total":1,"results":[{"id":"1","updatedAt":"2022-10-28T14:58:27Z"}]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	})
+
+	got, err := client.SearchByUpdatedAfter(context.Background(), "crm.taskQueues", time.Now(), 10, nil)
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	want := &ListResponse{
+		Total:   1,
+		Results: []ListResponseResult{{"id": "1", "updatedAt": "2022-10-28T14:58:27Z"}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Response body = %v, expected %v", got, want)
+	}
+}
+
+func TestClient_SearchByMultipleFields(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC)
+	millis := strconv.Itoa(int(timestamp.UnixMilli()))
+
+	tests := []struct {
+		name   string
+		search func(c *Client) error
+		want   SearchRequest
+	}{
+		{
+			name: "multiple_fields",
+			search: func(c *Client) error {
+				_, err := c.SearchByMultipleFields(context.Background(), "crm.contacts",
+					[]SearchRequestFilterGroupFilter{
+						{PropertyName: "lifecyclestage", Operator: "EQ", Value: "lead"},
+						{PropertyName: "hs_lastmodifieddate", Operator: GTEOperator, Value: millis},
+					},
+					[]SearchRequestSort{{PropertyName: "email", Direction: ASCSortDirection}},
+					10,
+					WithSearchProperties([]string{"email"}),
+					WithSearchAfter("100"),
+				)
+
+				return err
+			},
+			want: SearchRequest{
+				Limit:      "10",
+				Properties: []string{"email"},
+				FilterGroups: []SearchRequestFilterGroup{{Filters: []SearchRequestFilterGroupFilter{
+					{PropertyName: "lifecyclestage", Operator: "EQ", Value: "lead"},
+					{PropertyName: "hs_lastmodifieddate", Operator: GTEOperator, Value: millis},
+				}}},
+				Sorts: []SearchRequestSort{{PropertyName: "email", Direction: ASCSortDirection}},
+				After: "100",
+			},
+		},
+		{
+			name: "no_filters_no_limit",
+			search: func(c *Client) error {
+				_, err := c.SearchByMultipleFields(context.Background(), "crm.contacts", nil, nil, 0)
+
+				return err
+			},
+			want: SearchRequest{},
+		},
+		// the requests of the wrappers are the same as they were before they called the SearchByMultipleFields.
+		{
+			name: "updated_after",
+			search: func(c *Client) error {
+				_, err := c.SearchByUpdatedAfter(context.Background(), "crm.contacts", timestamp, 10, []string{"email"})
+
+				return err
+			},
+			want: SearchRequest{
+				Limit:      "10",
+				Properties: []string{"email"},
+				FilterGroups: []SearchRequestFilterGroup{{Filters: []SearchRequestFilterGroupFilter{
+					{PropertyName: "lastmodifieddate", Operator: GTEOperator, Value: millis},
+				}}},
+				Sorts: []SearchRequestSort{{PropertyName: "lastmodifieddate", Direction: ASCSortDirection}},
+			},
+		},
+		{
+			name: "created_before",
+			search: func(c *Client) error {
+				_, err := c.SearchByCreatedBefore(context.Background(), "crm.contacts", timestamp, 10, "100",
+					[]string{"email"}, "email")
+
+				return err
+			},
+			want: SearchRequest{
+				Limit:      "10",
+				Properties: []string{"email"},
+				FilterGroups: []SearchRequestFilterGroup{{Filters: []SearchRequestFilterGroupFilter{
+					{PropertyName: "createdate", Operator: LTEOperator, Value: millis},
+				}}},
+				Sorts: []SearchRequestSort{
+					{PropertyName: "email", Direction: ASCSortDirection},
+					{PropertyName: "hs_object_id", Direction: ASCSortDirection},
+				},
+				After: "100",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup()
+
+			t.Cleanup(func() {
+				teardown()
+			})
+
+			mux.HandleFunc("/crm/v3/objects/contacts/search", func(w http.ResponseWriter, r *http.Request) {
+				var req SearchRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("decode request body: %v", err)
+				}
+
+				if !reflect.DeepEqual(req, tt.want) {
+					t.Errorf("request body = %+v, expected %+v", req, tt.want)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if _, err := w.Write([]byte(`{"total":0,"results":[]}`)); err != nil {
+					t.Errorf("write body: %v", err)
+				}
+			})
+
+			if err := tt.search(client); err != nil {
+				t.Errorf("expected error to be nil, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_SearchByMultipleFields_unsupportedResource(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient("secret", http.DefaultClient)
+
+	_, err := client.SearchByMultipleFields(context.Background(), "cms.domains", nil, nil, 10)
+
+	var unsupportedResourceErr *UnsupportedResourceError
+	if !errors.As(err, &unsupportedResourceErr) {
+		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
+	}
+}
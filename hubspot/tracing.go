@@ -0,0 +1,46 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer starts spans for HubSpot API requests.
+// The hubspot/otel package provides an OpenTelemetry implementation of it.
+type Tracer interface {
+	// Start starts a new span as a child of the span within the context.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+	// Inject injects the trace context of the ctx into the HTTP headers.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a single traced HubSpot API request.
+type Span interface {
+	// SetStatusCode records the response's status code.
+	SetStatusCode(statusCode int)
+	// RecordError records an error occurred during the request.
+	RecordError(err error)
+	// End ends the span.
+	End()
+}
+
+// WithTracer sets a tracer that is used to trace HubSpot API requests.
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *Client) {
+		c.tracer = tracer
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// testTracer is a [Tracer] that records started spans.
+type testTracer struct {
+	spans []*testSpan
+}
+
+// Start records a new span.
+func (t *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName}
+	t.spans = append(t.spans, span)
+
+	return ctx, span
+}
+
+// Inject sets the traceparent header.
+func (t *testTracer) Inject(_ context.Context, header http.Header) {
+	header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+}
+
+// testSpan is a [Span] that records its status code, error and whether it's ended.
+type testSpan struct {
+	name       string
+	statusCode int
+	err        error
+	ended      bool
+}
+
+// SetStatusCode records the status code.
+func (s *testSpan) SetStatusCode(statusCode int) {
+	s.statusCode = statusCode
+}
+
+// RecordError records the error.
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+// End marks the span as ended.
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+func TestClient_do_withTracer(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	tracer := &testTracer{}
+	WithTracer(tracer)(client)
+
+	mux.HandleFunc("/crm/v3/objects/contacts/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") == "" {
+			t.Errorf("expected traceparent header to be set")
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.Delete(context.Background(), "crm.contacts", "1")
+
+	var unexpectedStatusCodeErr *UnexpectedStatusCodeError
+	if !errors.As(err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected error to be UnexpectedStatusCodeError, but got %v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("expected 1 span, but got %d", len(tracer.spans))
+	}
+
+	span := tracer.spans[0]
+
+	if span.name != "hubspot.DELETE /crm/v3/objects/contacts/1" {
+		t.Errorf("expected span name to be %q, but got %q", "hubspot.DELETE /crm/v3/objects/contacts/1", span.name)
+	}
+
+	if span.statusCode != http.StatusNotFound {
+		t.Errorf("expected span status code to be %d, but got %d", http.StatusNotFound, span.statusCode)
+	}
+
+	if !errors.As(span.err, &unexpectedStatusCodeErr) {
+		t.Errorf("expected span error to be UnexpectedStatusCodeError, but got %v", span.err)
+	}
+
+	if !span.ended {
+		t.Errorf("expected span to be ended")
+	}
+}
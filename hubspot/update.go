@@ -57,6 +57,10 @@ var ResourcesUpdatePaths = map[string]ResourceUpdatePath{
 	"cms.urlRedirects": {
 		Path: "/cms/v3/url-redirects/{objectId}", Method: http.MethodPatch,
 	},
+	// https://developers.hubspot.com/docs/api/marketing/forms
+	"cms.forms": {
+		Path: "/marketing/v3/forms/{objectId}", Method: http.MethodPatch,
+	},
 	// https://developers.hubspot.com/docs/api/crm/companies
 	"crm.companies": {
 		Path: "/crm/v3/objects/companies/{objectId}", Method: http.MethodPatch,
@@ -109,6 +113,18 @@ var ResourcesUpdatePaths = map[string]ResourceUpdatePath{
 	"crm.tasks": {
 		Path: "/crm/v3/objects/tasks/{objectId}", Method: http.MethodPatch,
 	},
+	// https://developers.hubspot.com/docs/api/crm/postal-mail
+	"crm.postalMail": {
+		Path: "/crm/v3/objects/postal_mail/{objectId}", Method: http.MethodPatch,
+	},
+	// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+	"crm.taskQueues": {
+		Path: "/crm/v3/objects/task_queues/{objectId}", Method: http.MethodPatch,
+	},
+	// https://developers.hubspot.com/docs/api/marketing/marketing-email
+	"marketing.emails": {
+		Path: "/marketing/v3/emails/{objectId}", Method: http.MethodPatch,
+	},
 }
 
 // Update tries to update an existing item of a specific resource.
@@ -128,7 +144,7 @@ func (c *Client) Update(ctx context.Context, resource, itemID string, item map[s
 		return fmt.Errorf("create new request: %w", err)
 	}
 
-	if err := c.do(req, nil); err != nil {
+	if err := c.do(req, nil, nil); err != nil {
 		return fmt.Errorf("execute request: %w", err)
 	}
 
@@ -55,6 +55,52 @@ func TestClient_Update_success(t *testing.T) {
 	}
 }
 
+func TestClient_Update_marketingEmails(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/emails/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPatch, r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.Update(context.Background(), "marketing.emails", "1", map[string]any{"name": "Newsletter"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Update_cmsForms(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/marketing/v3/forms/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPatch, r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.Update(context.Background(), "cms.forms", "1", map[string]any{"name": "Contact us"})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
 func TestClient_Update_unsupportedResource(t *testing.T) {
 	t.Parallel()
 
@@ -74,3 +120,53 @@ func TestClient_Update_unsupportedResource(t *testing.T) {
 		t.Errorf("expected error to be UnsupportedResourceError, but got %v", err)
 	}
 }
+
+func TestClient_Update_crmPostalMail(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/postal_mail/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPatch, r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.Update(context.Background(), "crm.postalMail", "1", map[string]any{
+		"properties": map[string]any{"hs_postal_mail_body": "Welcome letter"},
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
+
+func TestClient_Update_crmTaskQueues(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup()
+
+	t.Cleanup(func() {
+		teardown()
+	})
+
+	mux.HandleFunc("/crm/v3/objects/task_queues/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected method to be %s, but got %s", http.MethodPatch, r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.Update(context.Background(), "crm.taskQueues", "1", map[string]any{
+		"properties": map[string]any{"hs_task_queues_body": "Renewals"},
+	})
+	if err != nil {
+		t.Errorf("expected error to be nil, but got %v", err)
+	}
+}
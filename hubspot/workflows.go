@@ -0,0 +1,33 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+)
+
+const (
+	// WorkflowsResource is a name of the marketing automation workflows resource.
+	WorkflowsResource = "automation.workflows"
+
+	// workflowsPath is a path of the endpoint that lists marketing automation workflows.
+	// https://developers.hubspot.com/docs/api/automation/workflows
+	workflowsPath = "/automation/v4/flows"
+)
+
+// GetWorkflows returns a list of marketing automation workflows.
+func (c *Client) GetWorkflows(ctx context.Context, opts *ListOptions) (*ListResponse, error) {
+	return c.getList(ctx, workflowsPath, opts)
+}
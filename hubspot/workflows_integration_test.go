@@ -0,0 +1,49 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestClient_GetWorkflows_integration(t *testing.T) {
+	accessToken := os.Getenv("HUBSPOT_ACCESS_TOKEN")
+	if accessToken == "" {
+		t.Skip("HUBSPOT_ACCESS_TOKEN env var must be set")
+	}
+
+	client := NewClient(accessToken, &http.Client{
+		Timeout: 5 * time.Second,
+	})
+
+	listResponse, err := client.GetWorkflows(context.Background(), &ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	for _, workflow := range listResponse.Results {
+		if _, err := workflow.GetCreatedAt(WorkflowsResource); err != nil {
+			t.Errorf("expected workflow to have a creation date, but got %v", err)
+		}
+
+		if _, err := workflow.GetUpdatedAt(WorkflowsResource); err != nil {
+			t.Errorf("expected workflow to have an update date, but got %v", err)
+		}
+	}
+}
@@ -16,23 +16,137 @@ package source
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/source/iterator"
 	"github.com/conduitio-labs/conduit-connector-hubspot/validator"
 )
 
 const (
 	// ConfigKeyPollingPeriod is a config name for a polling period.
 	ConfigKeyPollingPeriod = "pollingPeriod"
+	// ConfigKeyPollingJitter is a config name for a polling jitter.
+	ConfigKeyPollingJitter = "pollingJitter"
 	// ConfigKeyBufferSize is a config name for a buffer size.
 	ConfigKeyBufferSize = "bufferSize"
 	// ConfigKeyExtraProperties is a config name for a extra properties.
 	ConfigKeyExtraProperties = "extraProperties"
+	// ConfigKeyExtraPropertiesCDC is a config name for extra properties used in CDC mode.
+	ConfigKeyExtraPropertiesCDC = "extraPropertiesCdc"
 	// ConfigKeySnapshot is a config name for a snapshot field.
 	ConfigKeySnapshot = "snapshot"
+	// ConfigKeyPropertyDenylist is a config name for a property denylist.
+	ConfigKeyPropertyDenylist = "propertyDenylist"
+	// ConfigKeyPropertyAllowlist is a config name for a property allowlist.
+	ConfigKeyPropertyAllowlist = "propertyAllowlist"
+	// ConfigKeySnapshotOrderBy is a config name for a snapshot order by field.
+	ConfigKeySnapshotOrderBy = "snapshotOrderBy"
+	// ConfigKeySkipEmptySnapshot is a config name for a skip empty snapshot field.
+	ConfigKeySkipEmptySnapshot = "skipEmptySnapshot"
+	// ConfigKeySnapshotWarningThreshold is a config name for a snapshot warning threshold field.
+	ConfigKeySnapshotWarningThreshold = "snapshotWarningThreshold"
+	// ConfigKeyValidateExtraProperties is a config name for a validate extra properties field.
+	ConfigKeyValidateExtraProperties = "validateExtraProperties"
+	// ConfigKeySnapshotMode is a config name for a snapshot mode field.
+	ConfigKeySnapshotMode = "snapshotMode"
+	// ConfigKeyResourceAlias is a config name for a resource alias.
+	ConfigKeyResourceAlias = "resourceAlias"
+	// ConfigKeyRecordKeyField is a config name for a record key field.
+	ConfigKeyRecordKeyField = "recordKeyField"
+	// ConfigKeyConversationThreadID is a config name for a conversation thread id.
+	ConfigKeyConversationThreadID = "conversationThreadId"
+	// ConfigKeySnapshotCheckpointInterval is a config name for a snapshot checkpoint interval.
+	ConfigKeySnapshotCheckpointInterval = "snapshotCheckpointInterval"
+	// ConfigKeyPositionFormat is a config name for a position format field.
+	ConfigKeyPositionFormat = "positionFormat"
+	// ConfigKeyMaxBufferWait is a config name for a max buffer wait.
+	ConfigKeyMaxBufferWait = "maxBufferWait"
+	// ConfigKeyResourceGroup is a config name for a resource group.
+	ConfigKeyResourceGroup = "resourceGroup"
+	// ConfigKeyResources is a config name for a list of resources.
+	ConfigKeyResources = "resources"
+	// ConfigKeyExcludeSystemProperties is a config name for an exclude system properties field.
+	ConfigKeyExcludeSystemProperties = "excludeSystemProperties"
+	// ConfigKeyWarmUpDelay is a config name for a warm up delay.
+	ConfigKeyWarmUpDelay = "warmUpDelay"
+	// ConfigKeyDedupWindow is a config name for a dedup window.
+	ConfigKeyDedupWindow = "dedupWindow"
+	// ConfigKeyBlogPostState is a config name for a blog post state.
+	ConfigKeyBlogPostState = "blogPostState"
+	// ConfigKeySnapshotFilter is a config name for a snapshot filter.
+	ConfigKeySnapshotFilter = "snapshotFilter"
+	// ConfigKeyRecordFormat is a config name for a record format field.
+	ConfigKeyRecordFormat = "recordFormat"
+	// ConfigKeyIncludeTranscript is a config name for an include transcript field.
+	ConfigKeyIncludeTranscript = "includeTranscript"
+	// ConfigKeySnapshotPageCursor is a config name for a snapshot page cursor.
+	ConfigKeySnapshotPageCursor = "snapshotPageCursor"
+	// ConfigKeyCMSPageState is a config name for a CMS page state.
+	ConfigKeyCMSPageState = "cmsPageState"
+	// ConfigKeySnapshotSortBy is a config name for a snapshot sort by field.
+	ConfigKeySnapshotSortBy = "snapshotSortBy"
+)
+
+// ResourceGroupEngagements is a resource group of all the CRM engagement types.
+const ResourceGroupEngagements = "crm.engagements"
+
+// snapshotOnlyConfigKeys holds the config names of the options that make sense only if the snapshot is enabled.
+var snapshotOnlyConfigKeys = []string{
+	ConfigKeySnapshotOrderBy,
+	ConfigKeySnapshotSortBy,
+	ConfigKeySnapshotMode,
+	ConfigKeySnapshotCheckpointInterval,
+	ConfigKeyBlogPostState,
+	ConfigKeySnapshotFilter,
+	ConfigKeySnapshotPageCursor,
+	ConfigKeyCMSPageState,
+}
+
+// resourceGroups holds the resources each resource group expands to.
+var resourceGroups = map[string][]string{
+	ResourceGroupEngagements: {"crm.calls", "crm.emails", "crm.meetings", "crm.notes", "crm.tasks"},
+}
+
+// unreadableResources holds the resources the client supports, but the source cannot read,
+// because their endpoints cannot be filtered by the creation or the update date.
+var unreadableResources = map[string]struct{}{
+	hubspot.WorkflowsResource: {},
+	"cms.forms":               {},
+}
+
+const (
+	// SnapshotModeSearch is a snapshot mode that pages through items using the list and search endpoints.
+	SnapshotModeSearch = "search"
+	// SnapshotModeBulk is a snapshot mode that retrieves all items at once using the HubSpot export API.
+	SnapshotModeBulk = "bulk"
+)
+
+const (
+	// SnapshotSortByCreatedAt is a snapshot sort by value that sorts items by their creation date.
+	SnapshotSortByCreatedAt = iterator.SortByCreatedAt
+	// SnapshotSortByUpdatedAt is a snapshot sort by value that sorts items by their last update date.
+	SnapshotSortByUpdatedAt = iterator.SortByUpdatedAt
+)
+
+const (
+	// PositionFormatJSON is a position format that marshals positions as JSON.
+	PositionFormatJSON = "json"
+	// PositionFormatProto is a position format that marshals positions as Protocol Buffers,
+	// which makes them smaller than JSON ones.
+	PositionFormatProto = "proto"
+)
+
+const (
+	// RecordFormatStructured is a record format that emits payloads as structured data.
+	RecordFormatStructured = "structured"
+	// RecordFormatJSON is a record format that emits payloads as raw data holding JSON-encoded items.
+	RecordFormatJSON = "json"
 )
 
 const (
@@ -42,6 +156,16 @@ const (
 	defaultBufferSize = 100
 	// defaultSnapshot is the default value for the snapshot field.
 	defaultSnapshot = true
+	// defaultSnapshotMode is the default value for the snapshotMode field.
+	defaultSnapshotMode = SnapshotModeSearch
+	// defaultRecordKeyField is the default value for the recordKeyField field.
+	defaultRecordKeyField = hubspot.ResultsFieldID
+	// defaultSnapshotCheckpointInterval is the default value for the snapshotCheckpointInterval field.
+	defaultSnapshotCheckpointInterval = 1
+	// defaultPositionFormat is the default value for the positionFormat field.
+	defaultPositionFormat = PositionFormatJSON
+	// defaultRecordFormat is the default value for the recordFormat field.
+	defaultRecordFormat = RecordFormatStructured
 )
 
 // Config holds source-specific configurable values.
@@ -51,6 +175,9 @@ type Config struct {
 	// PollingPeriod is the duration that defines a period of polling
 	// new items if CDC is not available for a resource.
 	PollingPeriod time.Duration `key:"pollingPeriod" validate:"gte=0"`
+	// PollingJitter is the upper bound of a random delay added to each polling period.
+	// It must be less than the PollingPeriod.
+	PollingJitter time.Duration `key:"pollingJitter" validate:"gte=0,ltfield=PollingPeriod"`
 	// BufferSize is the buffer size for consumed items.
 	// It will also be used as a limit when retrieving items from the HubSpot API.
 	BufferSize int `key:"bufferSize" validate:"gte=1,lte=100"`
@@ -59,23 +186,176 @@ type Config struct {
 	// on the requested HubSpot resource, they will be ignored.
 	// Only CRM resources support this.
 	ExtraProperties []string `key:"extraProperties"`
+	// ExtraPropertiesCDC holds a list of HubSpot resource properties to include
+	// in addition to the default in CDC mode only. If it's set, it overrides the ExtraProperties in CDC mode.
+	// Only CRM resources support this.
+	ExtraPropertiesCDC []string `key:"extraPropertiesCdc"`
 	// Snapshot determines whether the connector will take a snapshot or not
 	// of the entire collection before starting CDC mode.
 	Snapshot bool `key:"snapshot"`
+	// PropertyDenylist holds a list of HubSpot resource properties
+	// that will be removed from the properties of each record.
+	PropertyDenylist []string `key:"propertyDenylist"`
+	// PropertyAllowlist holds a list of HubSpot resource properties
+	// that will be kept in the properties of each record, all the others will be removed.
+	// It cannot be used together with the PropertyDenylist.
+	PropertyAllowlist []string `key:"propertyAllowlist"`
+	// ExcludeSystemProperties determines whether the HubSpot system properties, which names start with hs_,
+	// will be removed from the properties of each record. Record keys and positions are resolved before that,
+	// so the recordKeyField can still be one of them, e.g. hs_object_id, but its value won't be in the payload.
+	ExcludeSystemProperties bool `key:"excludeSystemProperties"`
+	// IncludeTranscript determines whether the transcript of each call is retrieved with a secondary request
+	// and added to its properties as the transcript property. Only the crm.calls resource supports this.
+	IncludeTranscript bool `key:"includeTranscript"`
+	// SnapshotOrderBy is the field name by which items are sorted during the snapshot.
+	// If it's empty, items are sorted by their creation date.
+	SnapshotOrderBy string `key:"snapshotOrderBy"`
+	// SnapshotSortBy defines whether items are sorted and filtered by their creation or last update date
+	// during the snapshot, it's either createdAt or updatedAt. If it's empty, the creation date is used.
+	// Sorting by the last update date is supported only by search-based resources in the search snapshot mode.
+	SnapshotSortBy string `key:"snapshotSortBy"`
+	// SkipEmptySnapshot determines whether the connector will switch to CDC mode
+	// right away if the snapshot doesn't contain any items.
+	SkipEmptySnapshot bool `key:"skipEmptySnapshot"`
+	// SnapshotWarningThreshold is the number of items above which the connector suggests
+	// disabling the snapshot if it's enabled by default and there's no position.
+	// If it's zero, the suggestion is always logged.
+	SnapshotWarningThreshold int `key:"snapshotWarningThreshold" validate:"gte=0"`
+	// ValidateExtraProperties determines whether the connector will check on open
+	// that all the ExtraProperties exist on the resource.
+	ValidateExtraProperties bool `key:"validateExtraProperties"`
+	// SnapshotMode defines how the snapshot retrieves items, it's either search or bulk.
+	// The bulk mode is supported only by CRM resources that can be exported.
+	SnapshotMode string `key:"snapshotMode"`
+	// BlogPostState filters the snapshot items of the cms.blogs.posts resource by their publication state,
+	// it's one of DRAFT, SCHEDULED, PUBLISHED. If it's empty, posts of all states are read.
+	BlogPostState hubspot.BlogPostState `key:"blogPostState"`
+	// CMSPageState filters the snapshot items of the cms.pages.site resource by their publication state,
+	// it's one of DRAFT, PUBLISHED. If it's empty, pages of all states are read.
+	CMSPageState hubspot.SitePageState `key:"cmsPageState"`
+	// SnapshotFilter is a query the snapshot items are searched by instead of being listed.
+	// Only the cms.urlRedirects resource supports it.
+	SnapshotFilter string `key:"snapshotFilter"`
+	// SnapshotPageCursor is a pagination cursor, e.g. from HubSpot API logs, the snapshot starts from
+	// if there's no position, so that an interrupted snapshot can be resumed from a known page.
+	SnapshotPageCursor string `key:"snapshotPageCursor"`
+	// ResourceAlias replaces the resource name in the collection metadata of records if it's not empty.
+	ResourceAlias string `key:"resourceAlias"`
+	// RecordKeyField is the item's field which value becomes the record key.
+	// It's looked up among the item's fields first, and then among its properties.
+	RecordKeyField string `key:"recordKeyField"`
+	// ConversationThreadID is the id of a conversation thread which messages the connector will read.
+	// It's required if the Resource is conversations.messages.
+	ConversationThreadID string `key:"conversationThreadId"`
+	// SnapshotCheckpointInterval is the number of snapshot records after which the position is updated.
+	// The last record of each page always updates the position.
+	SnapshotCheckpointInterval int `key:"snapshotCheckpointInterval" validate:"gte=1"`
+	// PositionFormat defines how positions are marshaled, it's either json or proto.
+	// Positions of both formats are read regardless of this field.
+	PositionFormat string `key:"positionFormat"`
+	// RecordFormat defines how record payloads are emitted, it's either structured or json.
+	// Record keys are structured regardless of this field.
+	RecordFormat string `key:"recordFormat"`
+	// MaxBufferWait is the maximum time the source waits for a free slot of the records buffer
+	// before it fails. If it's zero, which is the default, the source waits until the buffer has room.
+	MaxBufferWait time.Duration `key:"maxBufferWait" validate:"gte=0"`
+	// WarmUpDelay is the time to wait after the snapshot before switching to CDC mode.
+	// HubSpot's search index lags behind writes, so it reduces the chance of missing items
+	// that are created or updated while the snapshot is taken.
+	WarmUpDelay time.Duration `key:"warmUpDelay" validate:"gte=0"`
+	// DedupWindow is the duration within which the same update of an item is emitted only once in CDC mode,
+	// as HubSpot may return the same update several times. If it's zero, the deduplication is disabled.
+	DedupWindow time.Duration `key:"dedupWindow" validate:"gte=0"`
+	// ResourceGroup is the name of a group of resources which records are read by a single source.
+	// If it's set, the resource must be empty, and the Resource is the first resource of the group,
+	// so that the resource-specific options are checked against it.
+	ResourceGroup string `key:"resourceGroup"`
+	// Resources holds the names of the resources which records are read by a single source.
+	// If it's set, the resource and the resourceGroup must be empty, and the Resource is the first of them,
+	// so that the resource-specific options are checked against it.
+	Resources []string `key:"resources"`
 }
 
 // ParseConfig seeks to parse a provided map[string]string into a Config struct.
 func ParseConfig(cfg map[string]string) (Config, error) {
+	// parse resourceGroup if it's not empty.
+	resourceGroup := strings.TrimSpace(cfg[ConfigKeyResourceGroup])
+	if resourceGroup != "" {
+		resources, ok := resourceGroups[resourceGroup]
+		if !ok {
+			return Config{}, &UnknownResourceGroupError{
+				ResourceGroup: resourceGroup,
+			}
+		}
+
+		if cfg[config.KeyResource] != "" {
+			return Config{}, ErrResourceAndResourceGroup
+		}
+
+		// the common config requires a resource, so the first resource of the group is used.
+		cfg = maps.Clone(cfg)
+		cfg[config.KeyResource] = resources[0]
+	}
+
+	// parse resources if it's not empty.
+	resources := parseList(cfg[ConfigKeyResources])
+	if len(resources) > 0 {
+		if resourceGroup != "" {
+			return Config{}, ErrResourcesAndResourceGroup
+		}
+
+		if cfg[config.KeyResource] != "" {
+			return Config{}, ErrResourceAndResources
+		}
+
+		for i, resource := range resources {
+			if _, ok := hubspot.ResourcesListPaths[resource]; !ok {
+				return Config{}, &hubspot.UnsupportedResourceError{
+					Resource: resource,
+				}
+			}
+
+			// positions are keyed by resource, so a duplicate would share the position of the other one.
+			if slices.Contains(resources[:i], resource) {
+				return Config{}, &DuplicateResourceError{
+					Resource: resource,
+				}
+			}
+		}
+
+		// the common config requires a resource, so the first resource of the list is used.
+		cfg = maps.Clone(cfg)
+		cfg[config.KeyResource] = resources[0]
+	}
+
 	commonConfig, err := config.Parse(cfg)
 	if err != nil {
 		return Config{}, fmt.Errorf("parse common config: %w", err)
 	}
 
 	sourceConfig := Config{
-		Config:        commonConfig,
-		PollingPeriod: defaultPollingPeriod,
-		BufferSize:    defaultBufferSize,
-		Snapshot:      defaultSnapshot,
+		Config:                     commonConfig,
+		PollingPeriod:              defaultPollingPeriod,
+		BufferSize:                 defaultBufferSize,
+		Snapshot:                   defaultSnapshot,
+		SnapshotMode:               defaultSnapshotMode,
+		RecordKeyField:             defaultRecordKeyField,
+		SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+		PositionFormat:             defaultPositionFormat,
+		RecordFormat:               defaultRecordFormat,
+		ResourceGroup:              resourceGroup,
+	}
+
+	if len(resources) > 0 {
+		sourceConfig.Resources = resources
+	}
+
+	for _, resource := range sourceConfig.resources() {
+		if _, ok := unreadableResources[resource]; ok {
+			return Config{}, &UnreadableResourceError{
+				Resource: resource,
+			}
+		}
 	}
 
 	// parse pollingPeriod if it's not empty.
@@ -90,6 +370,46 @@ func ParseConfig(cfg map[string]string) (Config, error) {
 		}
 	}
 
+	// parse pollingJitter if it's not empty.
+	if pollingJitterStr := cfg[ConfigKeyPollingJitter]; pollingJitterStr != "" {
+		pollingJitter, err := time.ParseDuration(pollingJitterStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse polling jitter: %w", err)
+		}
+
+		sourceConfig.PollingJitter = pollingJitter
+	}
+
+	// parse maxBufferWait if it's not empty.
+	if maxBufferWaitStr := cfg[ConfigKeyMaxBufferWait]; maxBufferWaitStr != "" {
+		maxBufferWait, err := time.ParseDuration(maxBufferWaitStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse max buffer wait: %w", err)
+		}
+
+		sourceConfig.MaxBufferWait = maxBufferWait
+	}
+
+	// parse warmUpDelay if it's not empty.
+	if warmUpDelayStr := cfg[ConfigKeyWarmUpDelay]; warmUpDelayStr != "" {
+		warmUpDelay, err := time.ParseDuration(warmUpDelayStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse warm up delay: %w", err)
+		}
+
+		sourceConfig.WarmUpDelay = warmUpDelay
+	}
+
+	// parse dedupWindow if it's not empty.
+	if dedupWindowStr := cfg[ConfigKeyDedupWindow]; dedupWindowStr != "" {
+		dedupWindow, err := time.ParseDuration(dedupWindowStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse dedup window: %w", err)
+		}
+
+		sourceConfig.DedupWindow = dedupWindow
+	}
+
 	// parse bufferSize if it's not empty.
 	if bufferSizeStr := cfg[ConfigKeyBufferSize]; bufferSizeStr != "" {
 		bufferSize, err := strconv.Atoi(bufferSizeStr)
@@ -102,9 +422,74 @@ func ParseConfig(cfg map[string]string) (Config, error) {
 
 	// parse extraProperties if it's not empty.
 	if extraPropertiesStr := cfg[ConfigKeyExtraProperties]; extraPropertiesStr != "" {
-		sourceConfig.ExtraProperties = strings.FieldsFunc(extraPropertiesStr, func(r rune) bool {
-			return r == ',' || r == ' '
-		})
+		sourceConfig.ExtraProperties = parseList(extraPropertiesStr)
+	}
+
+	// only search-based resources return extra properties, the others would silently ignore them.
+	if len(sourceConfig.ExtraProperties) > 0 {
+		for _, resource := range sourceConfig.resources() {
+			if _, ok := hubspot.SearchResources[resource]; !ok {
+				return Config{}, &ExtraPropertiesNotSupportedError{
+					Key:      ConfigKeyExtraProperties,
+					Resource: resource,
+				}
+			}
+		}
+	}
+
+	// parse extraPropertiesCdc if it's not empty.
+	if extraPropertiesCDCStr := cfg[ConfigKeyExtraPropertiesCDC]; extraPropertiesCDCStr != "" {
+		sourceConfig.ExtraPropertiesCDC = parseList(extraPropertiesCDCStr)
+	}
+
+	// the CDC extra properties are requested by the same search endpoint.
+	if len(sourceConfig.ExtraPropertiesCDC) > 0 {
+		for _, resource := range sourceConfig.resources() {
+			if _, ok := hubspot.SearchResources[resource]; !ok {
+				return Config{}, &ExtraPropertiesNotSupportedError{
+					Key:      ConfigKeyExtraPropertiesCDC,
+					Resource: resource,
+				}
+			}
+		}
+	}
+
+	// parse propertyDenylist if it's not empty.
+	if propertyDenylistStr := cfg[ConfigKeyPropertyDenylist]; propertyDenylistStr != "" {
+		sourceConfig.PropertyDenylist = parseList(propertyDenylistStr)
+	}
+
+	// parse propertyAllowlist if it's not empty.
+	if propertyAllowlistStr := cfg[ConfigKeyPropertyAllowlist]; propertyAllowlistStr != "" {
+		sourceConfig.PropertyAllowlist = parseList(propertyAllowlistStr)
+	}
+
+	if len(sourceConfig.PropertyAllowlist) > 0 && len(sourceConfig.PropertyDenylist) > 0 {
+		return Config{}, ErrPropertyAllowlistAndDenylist
+	}
+
+	// parse excludeSystemProperties if it's not empty.
+	if excludeSystemPropertiesStr := cfg[ConfigKeyExcludeSystemProperties]; excludeSystemPropertiesStr != "" {
+		excludeSystemProperties, err := strconv.ParseBool(excludeSystemPropertiesStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse exclude system properties: %w", err)
+		}
+
+		sourceConfig.ExcludeSystemProperties = excludeSystemProperties
+	}
+
+	// parse includeTranscript if it's not empty.
+	if includeTranscriptStr := cfg[ConfigKeyIncludeTranscript]; includeTranscriptStr != "" {
+		includeTranscript, err := strconv.ParseBool(includeTranscriptStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse include transcript: %w", err)
+		}
+
+		if includeTranscript && sourceConfig.Resource != hubspot.CallsResource {
+			return Config{}, ErrIncludeTranscriptUnsupportedResource
+		}
+
+		sourceConfig.IncludeTranscript = includeTranscript
 	}
 
 	// parse snapshot if it's not empty
@@ -117,9 +502,271 @@ func ParseConfig(cfg map[string]string) (Config, error) {
 		sourceConfig.Snapshot = snapshot
 	}
 
+	// parse skipEmptySnapshot if it's not empty.
+	if skipEmptySnapshotStr := cfg[ConfigKeySkipEmptySnapshot]; skipEmptySnapshotStr != "" {
+		skipEmptySnapshot, err := strconv.ParseBool(skipEmptySnapshotStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse skip empty snapshot: %w", err)
+		}
+
+		sourceConfig.SkipEmptySnapshot = skipEmptySnapshot
+	}
+
+	// parse snapshotOrderBy if it's not empty.
+	if snapshotOrderByStr := cfg[ConfigKeySnapshotOrderBy]; snapshotOrderByStr != "" {
+		sourceConfig.SnapshotOrderBy = strings.TrimSpace(snapshotOrderByStr)
+		if sourceConfig.SnapshotOrderBy == "" {
+			return Config{}, ErrBlankSnapshotOrderBy
+		}
+	}
+
+	// parse snapshotWarningThreshold if it's not empty.
+	if snapshotWarningThresholdStr := cfg[ConfigKeySnapshotWarningThreshold]; snapshotWarningThresholdStr != "" {
+		snapshotWarningThreshold, err := strconv.Atoi(snapshotWarningThresholdStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse snapshot warning threshold: %w", err)
+		}
+
+		sourceConfig.SnapshotWarningThreshold = snapshotWarningThreshold
+	}
+
+	// parse snapshotCheckpointInterval if it's not empty.
+	if snapshotCheckpointIntervalStr := cfg[ConfigKeySnapshotCheckpointInterval]; snapshotCheckpointIntervalStr != "" {
+		snapshotCheckpointInterval, err := strconv.Atoi(snapshotCheckpointIntervalStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse snapshot checkpoint interval: %w", err)
+		}
+
+		sourceConfig.SnapshotCheckpointInterval = snapshotCheckpointInterval
+	}
+
+	// parse validateExtraProperties if it's not empty.
+	if validateExtraPropertiesStr := cfg[ConfigKeyValidateExtraProperties]; validateExtraPropertiesStr != "" {
+		validateExtraProperties, err := strconv.ParseBool(validateExtraPropertiesStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("parse validate extra properties: %w", err)
+		}
+
+		sourceConfig.ValidateExtraProperties = validateExtraProperties
+	}
+
+	// parse snapshotMode if it's not empty.
+	if snapshotModeStr := cfg[ConfigKeySnapshotMode]; snapshotModeStr != "" {
+		sourceConfig.SnapshotMode = snapshotModeStr
+
+		switch sourceConfig.SnapshotMode {
+		case SnapshotModeSearch:
+		case SnapshotModeBulk:
+			for _, resource := range sourceConfig.resources() {
+				if _, ok := hubspot.ResourcesExportObjectTypes[resource]; !ok {
+					return Config{}, ErrBulkSnapshotUnsupportedResource
+				}
+			}
+
+		default:
+			return Config{}, ErrInvalidSnapshotMode
+		}
+	}
+
+	// parse snapshotSortBy if it's not empty.
+	if snapshotSortByStr := cfg[ConfigKeySnapshotSortBy]; snapshotSortByStr != "" {
+		sourceConfig.SnapshotSortBy = snapshotSortByStr
+
+		switch sourceConfig.SnapshotSortBy {
+		case SnapshotSortByCreatedAt:
+		case SnapshotSortByUpdatedAt:
+			if sourceConfig.SnapshotOrderBy != "" {
+				return Config{}, ErrSnapshotSortByAndOrderBy
+			}
+
+			if sourceConfig.SnapshotMode == SnapshotModeBulk {
+				return Config{}, ErrSnapshotSortByUnsupported
+			}
+
+			// only the search API filters items by their last update date.
+			for _, resource := range sourceConfig.resources() {
+				if _, ok := hubspot.SearchResources[resource]; !ok {
+					return Config{}, ErrSnapshotSortByUnsupported
+				}
+			}
+
+		default:
+			return Config{}, ErrInvalidSnapshotSortBy
+		}
+	}
+
+	// parse blogPostState if it's not empty.
+	if blogPostStateStr := cfg[ConfigKeyBlogPostState]; blogPostStateStr != "" {
+		sourceConfig.BlogPostState = hubspot.BlogPostState(strings.TrimSpace(blogPostStateStr))
+		if !slices.Contains(hubspot.BlogPostStates, sourceConfig.BlogPostState) {
+			return Config{}, ErrInvalidBlogPostState
+		}
+
+		for _, resource := range sourceConfig.resources() {
+			if resource != hubspot.BlogPostsResource {
+				return Config{}, ErrBlogPostStateUnsupportedResource
+			}
+		}
+	}
+
+	// parse cmsPageState if it's not empty.
+	if cmsPageStateStr := cfg[ConfigKeyCMSPageState]; cmsPageStateStr != "" {
+		sourceConfig.CMSPageState = hubspot.SitePageState(strings.TrimSpace(cmsPageStateStr))
+		if !slices.Contains(hubspot.SitePageStates, sourceConfig.CMSPageState) {
+			return Config{}, ErrInvalidCMSPageState
+		}
+
+		for _, resource := range sourceConfig.resources() {
+			if resource != hubspot.SitePagesResource {
+				return Config{}, ErrCMSPageStateUnsupportedResource
+			}
+		}
+	}
+
+	// parse snapshotFilter if it's not empty.
+	if snapshotFilterStr := cfg[ConfigKeySnapshotFilter]; snapshotFilterStr != "" {
+		sourceConfig.SnapshotFilter = strings.TrimSpace(snapshotFilterStr)
+		if sourceConfig.SnapshotFilter == "" {
+			return Config{}, ErrBlankSnapshotFilter
+		}
+
+		for _, resource := range sourceConfig.resources() {
+			if resource != hubspot.URLRedirectsResource {
+				return Config{}, ErrSnapshotFilterUnsupportedResource
+			}
+		}
+	}
+
+	// parse snapshotPageCursor if it's not empty.
+	if snapshotPageCursorStr := cfg[ConfigKeySnapshotPageCursor]; snapshotPageCursorStr != "" {
+		sourceConfig.SnapshotPageCursor = strings.TrimSpace(snapshotPageCursorStr)
+		if sourceConfig.SnapshotPageCursor == "" {
+			return Config{}, ErrBlankSnapshotPageCursor
+		}
+
+		// the cursor belongs to a page of a single resource, and the bulk snapshot isn't paged.
+		if len(sourceConfig.resources()) > 1 || sourceConfig.SnapshotMode == SnapshotModeBulk {
+			return Config{}, ErrSnapshotPageCursorUnsupported
+		}
+	}
+
+	// parse resourceAlias if it's not empty.
+	if resourceAliasStr := cfg[ConfigKeyResourceAlias]; resourceAliasStr != "" {
+		sourceConfig.ResourceAlias = strings.TrimSpace(resourceAliasStr)
+		if sourceConfig.ResourceAlias == "" || strings.Contains(sourceConfig.ResourceAlias, ".") {
+			return Config{}, ErrInvalidResourceAlias
+		}
+	}
+
+	// parse recordKeyField if it's not empty.
+	if recordKeyFieldStr := cfg[ConfigKeyRecordKeyField]; recordKeyFieldStr != "" {
+		sourceConfig.RecordKeyField = strings.TrimSpace(recordKeyFieldStr)
+		if sourceConfig.RecordKeyField == "" {
+			return Config{}, ErrBlankRecordKeyField
+		}
+	}
+
+	// parse conversationThreadId if it's not empty.
+	if conversationThreadIDStr := cfg[ConfigKeyConversationThreadID]; conversationThreadIDStr != "" {
+		sourceConfig.ConversationThreadID = strings.TrimSpace(conversationThreadIDStr)
+	}
+
+	if slices.Contains(sourceConfig.resources(), hubspot.ConversationMessagesResource) && sourceConfig.ConversationThreadID == "" {
+		return Config{}, ErrMissingConversationThreadID
+	}
+
+	// parse positionFormat if it's not empty.
+	if positionFormatStr := cfg[ConfigKeyPositionFormat]; positionFormatStr != "" {
+		switch positionFormatStr {
+		case PositionFormatJSON, PositionFormatProto:
+			sourceConfig.PositionFormat = positionFormatStr
+
+		default:
+			return Config{}, ErrInvalidPositionFormat
+		}
+	}
+
+	// parse recordFormat if it's not empty.
+	if recordFormatStr := cfg[ConfigKeyRecordFormat]; recordFormatStr != "" {
+		switch recordFormatStr {
+		case RecordFormatStructured, RecordFormatJSON:
+			sourceConfig.RecordFormat = recordFormatStr
+
+		default:
+			return Config{}, ErrInvalidRecordFormat
+		}
+	}
+
+	// the snapshot-only options would be silently ignored if the snapshot is disabled.
+	if !sourceConfig.Snapshot {
+		for _, key := range snapshotOnlyConfigKeys {
+			if cfg[key] != "" {
+				return Config{}, &SnapshotOptionWithoutSnapshotError{
+					Key: key,
+				}
+			}
+		}
+	}
+
 	if err := validator.ValidateStruct(sourceConfig); err != nil {
 		return Config{}, fmt.Errorf("validate source config: %w", err)
 	}
 
 	return sourceConfig, nil
 }
+
+// resources returns the resources of the ResourceGroup or the Resources if one of them is set,
+// otherwise it returns the Resource.
+func (c Config) resources() []string {
+	if c.ResourceGroup != "" {
+		return resourceGroups[c.ResourceGroup]
+	}
+
+	if len(c.Resources) > 0 {
+		return c.Resources
+	}
+
+	return []string{c.Resource}
+}
+
+// requestedProperties returns the extra properties merged with the property allowlist,
+// so that all the allowed properties are requested from the HubSpot API.
+func (c Config) requestedProperties() []string {
+	return c.withPropertyAllowlist(c.ExtraProperties)
+}
+
+// requestedCDCProperties returns the CDC extra properties merged with the property allowlist.
+// If the CDC extra properties are empty, the method returns nil, so that the CDC iterator uses the extra properties.
+func (c Config) requestedCDCProperties() []string {
+	if len(c.ExtraPropertiesCDC) == 0 {
+		return nil
+	}
+
+	return c.withPropertyAllowlist(c.ExtraPropertiesCDC)
+}
+
+// withPropertyAllowlist merges the provided extra properties with the property allowlist.
+func (c Config) withPropertyAllowlist(extraProperties []string) []string {
+	if len(c.PropertyAllowlist) == 0 {
+		return extraProperties
+	}
+
+	properties := make([]string, 0, len(extraProperties)+len(c.PropertyAllowlist))
+	properties = append(properties, extraProperties...)
+
+	for _, property := range c.PropertyAllowlist {
+		if !slices.Contains(properties, property) {
+			properties = append(properties, property)
+		}
+	}
+
+	return properties
+}
+
+// parseList splits a comma-separated string into a list of values,
+// skipping redundant spaces and commas.
+func parseList(str string) []string {
+	return strings.FieldsFunc(str, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
 )
 
 func TestParseConfig(t *testing.T) {
@@ -45,13 +46,21 @@ func TestParseConfig(t *testing.T) {
 			},
 			want: Config{
 				Config: config.Config{
-					AccessToken: "access_token",
-					Resource:    "crm.contacts",
-					MaxRetries:  config.DefaultMaxRetries,
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
 				},
-				PollingPeriod: defaultPollingPeriod,
-				BufferSize:    defaultBufferSize,
-				Snapshot:      defaultSnapshot,
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
 			},
 			wantErr: false,
 		},
@@ -69,13 +78,21 @@ func TestParseConfig(t *testing.T) {
 			},
 			want: Config{
 				Config: config.Config{
-					AccessToken: "access_token",
-					Resource:    "crm.contacts",
-					MaxRetries:  10,
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         10,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
 				},
-				PollingPeriod: time.Second * 10,
-				BufferSize:    100,
-				Snapshot:      false,
+				PollingPeriod:              time.Second * 10,
+				BufferSize:                 100,
+				Snapshot:                   false,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
 			},
 			wantErr: false,
 		},
@@ -90,13 +107,21 @@ func TestParseConfig(t *testing.T) {
 			},
 			want: Config{
 				Config: config.Config{
-					AccessToken: "access_token",
-					Resource:    "crm.contacts",
-					MaxRetries:  config.DefaultMaxRetries,
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
 				},
-				PollingPeriod: defaultPollingPeriod,
-				BufferSize:    defaultBufferSize,
-				Snapshot:      defaultSnapshot,
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
 			},
 			wantErr: false,
 		},
@@ -111,14 +136,22 @@ func TestParseConfig(t *testing.T) {
 			},
 			want: Config{
 				Config: config.Config{
-					AccessToken: "access_token",
-					Resource:    "crm.contacts",
-					MaxRetries:  config.DefaultMaxRetries,
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
 				},
-				PollingPeriod:   defaultPollingPeriod,
-				BufferSize:      defaultBufferSize,
-				ExtraProperties: []string{"name", "email"},
-				Snapshot:        defaultSnapshot,
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				ExtraProperties:            []string{"name", "email"},
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
 			},
 			wantErr: false,
 		},
@@ -133,14 +166,206 @@ func TestParseConfig(t *testing.T) {
 			},
 			want: Config{
 				Config: config.Config{
-					AccessToken: "access_token",
-					Resource:    "crm.contacts",
-					MaxRetries:  config.DefaultMaxRetries,
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
 				},
-				PollingPeriod:   defaultPollingPeriod,
-				BufferSize:      defaultBufferSize,
-				ExtraProperties: []string{"name", "email", "createdAt", "updatedAt"},
-				Snapshot:        defaultSnapshot,
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				ExtraProperties:            []string{"name", "email", "createdAt", "updatedAt"},
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_extra_properties_cdc",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "crm.contacts",
+					ConfigKeyExtraPropertiesCDC: "name,email",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				ExtraPropertiesCDC:         []string{"name", "email"},
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_extra_properties_and_extra_properties_cdc",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "crm.contacts",
+					ConfigKeyExtraProperties:    "name",
+					ConfigKeyExtraPropertiesCDC: "email,phone",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				ExtraProperties:            []string{"name"},
+				ExtraPropertiesCDC:         []string{"email", "phone"},
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_empty_extra_properties_and_extra_properties_cdc",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "crm.contacts",
+					ConfigKeyExtraProperties:    "",
+					ConfigKeyExtraPropertiesCDC: "",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_property_denylist",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:     "access_token",
+					config.KeyResource:        "crm.contacts",
+					ConfigKeyPropertyDenylist: "hs_object_id, createdate",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				PropertyDenylist:           []string{"hs_object_id", "createdate"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_property_allowlist",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeyPropertyAllowlist: "email,firstname",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				PropertyAllowlist:          []string{"email", "firstname"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_property_allowlist_and_empty_denylist",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeyPropertyAllowlist: "email",
+					ConfigKeyPropertyDenylist:  " , ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				PropertyDenylist:           []string{},
+				PropertyAllowlist:          []string{"email"},
 			},
 			wantErr: false,
 		},
@@ -148,20 +373,1308 @@ func TestParseConfig(t *testing.T) {
 			name: "fail_missing_required_common_config_value",
 			args: args{
 				cfg: map[string]string{
-					config.KeyResource:     "crm.contacts",
-					ConfigKeyPollingPeriod: "10s",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyPollingPeriod: "10s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_invalid_polling_period",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyPollingPeriod: "ten seconds",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_polling_jitter",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyPollingPeriod: "10s",
+					ConfigKeyPollingJitter: "2s",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              time.Second * 10,
+				PollingJitter:              time.Second * 2,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_polling_jitter",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyPollingJitter: "two seconds",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_polling_jitter_not_less_than_polling_period",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyPollingPeriod: "10s",
+					ConfigKeyPollingJitter: "10s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_validate_extra_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:            "access_token",
+					config.KeyResource:               "crm.contacts",
+					ConfigKeyExtraProperties:         "industry",
+					ConfigKeyValidateExtraProperties: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				ExtraProperties:            []string{"industry"},
+				ValidateExtraProperties:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_validate_extra_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:            "access_token",
+					config.KeyResource:               "crm.contacts",
+					ConfigKeyValidateExtraProperties: "yes please",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_bulk_snapshot_mode",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeySnapshotMode: "bulk",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               SnapshotModeBulk,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_snapshot_mode",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeySnapshotMode: "export",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_bulk_snapshot_mode_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.blogs.posts",
+					ConfigKeySnapshotMode: "bulk",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_bulk_snapshot_mode_unsupported_resource_in_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "crm.contacts,cms.blogs.posts",
+					ConfigKeySnapshotMode: "bulk",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_resource_alias",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyResourceAlias: " contacts ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				ResourceAlias:              "contacts",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_blank_resource_alias",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyResourceAlias: "  ",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resource_alias_with_dots",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyResourceAlias: "crm.contacts",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_marketing_emails",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "marketing.emails",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "marketing.emails",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_cms_resource_without_extra_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.blogs.posts",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.blogs.posts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_cms_resource_with_extra_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					config.KeyResource:       "cms.blogs.posts",
+					ConfigKeyExtraProperties: "prop1,prop2",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_cms_resource_with_extra_properties_cdc",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "cms.blogs.posts",
+					ConfigKeyExtraPropertiesCDC: "prop1,prop2",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_record_key_field",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeyRecordKeyField: " email ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             "email",
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_blank_record_key_field",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeyRecordKeyField: "  ",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_conversation_thread_id",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:         "access_token",
+					config.KeyResource:            "conversations.messages",
+					ConfigKeyConversationThreadID: " 42 ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "conversations.messages",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				ConversationThreadID:       "42",
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_missing_conversation_thread_id",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "conversations.messages",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_unreadable_resource_workflows",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "automation.workflows",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_unreadable_resource_forms",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.forms",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_unreadable_resource_in_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "crm.contacts,automation.workflows",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_custom_snapshot_checkpoint_interval",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:               "access_token",
+					config.KeyResource:                  "crm.contacts",
+					ConfigKeySnapshotCheckpointInterval: "50",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: 50,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_snapshot_checkpoint_interval_not_a_number",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:               "access_token",
+					config.KeyResource:                  "crm.contacts",
+					ConfigKeySnapshotCheckpointInterval: "ten",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_invalid_snapshot_checkpoint_interval_zero",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:               "access_token",
+					config.KeyResource:                  "crm.contacts",
+					ConfigKeySnapshotCheckpointInterval: "0",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_position_format_proto",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeyPositionFormat: "proto",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             PositionFormatProto,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_position_format",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeyPositionFormat: "xml",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_record_format_json",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyRecordFormat: "json",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               RecordFormatJSON,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_record_format",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyRecordFormat: "xml",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_max_buffer_wait",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyMaxBufferWait: "10s",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				MaxBufferWait:              time.Second * 10,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_max_buffer_wait",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyMaxBufferWait: "ten",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_negative_max_buffer_wait",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "crm.contacts",
+					ConfigKeyMaxBufferWait: "-1s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_warm_up_delay",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWarmUpDelay:  "10s",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				WarmUpDelay:                time.Second * 10,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_warm_up_delay",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWarmUpDelay:  "ten",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_negative_warm_up_delay",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyWarmUpDelay:  "-1s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_dedup_window",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyDedupWindow:  "1m",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				DedupWindow:                time.Minute,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_dedup_window",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyDedupWindow:  "one",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_negative_dedup_window",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyDedupWindow:  "-1s",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_blog_post_state",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "cms.blogs.posts",
+					ConfigKeyBlogPostState: "PUBLISHED",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.blogs.posts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				BlogPostState:              hubspot.BlogPostStatePublished,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_cms_page_state",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.pages.site",
+					ConfigKeyCMSPageState: "DRAFT",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.pages.site",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				CMSPageState:               hubspot.SitePageStateDraft,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_cms_page_state",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.pages.site",
+					ConfigKeyCMSPageState: "SCHEDULED",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_cms_page_state_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "cms.blogs.posts",
+					ConfigKeyCMSPageState: "DRAFT",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_cms_page_state_unsupported_resource_in_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "cms.pages.site,cms.blogs.posts",
+					ConfigKeyCMSPageState: "DRAFT",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_invalid_blog_post_state",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "cms.blogs.posts",
+					ConfigKeyBlogPostState: "ARCHIVED",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_blog_post_state_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "cms.blogs.authors",
+					ConfigKeyBlogPostState: "DRAFT",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_blog_post_state_unsupported_resource_in_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					ConfigKeyResources:     "cms.blogs.posts,cms.blogs.authors",
+					ConfigKeyBlogPostState: "DRAFT",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_blog_post_state_without_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					config.KeyResource:     "cms.blogs.posts",
+					ConfigKeySnapshot:      "false",
+					ConfigKeyBlogPostState: "DRAFT",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_snapshot_filter",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "cms.urlRedirects",
+					ConfigKeySnapshotFilter: " /blog ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.urlRedirects",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				SnapshotFilter:             "/blog",
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_snapshot_page_cursor",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "cms.blogs.authors",
+					ConfigKeySnapshotPageCursor: " NTI1Cg== ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "cms.blogs.authors",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				SnapshotPageCursor:         "NTI1Cg==",
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_blank_snapshot_page_cursor",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "cms.blogs.authors",
+					ConfigKeySnapshotPageCursor: "  ",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_page_cursor_bulk_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "crm.contacts",
+					ConfigKeySnapshotMode:       SnapshotModeBulk,
+					ConfigKeySnapshotPageCursor: "NTI1Cg==",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_page_cursor_resource_group",
+			args: args{
+				cfg: map[string]string{
+					ConfigKeyResourceGroup:      ResourceGroupEngagements,
+					config.KeyAccessToken:       "access_token",
+					ConfigKeySnapshotPageCursor: "NTI1Cg==",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_page_cursor_without_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					config.KeyResource:          "cms.blogs.authors",
+					ConfigKeySnapshot:           "false",
+					ConfigKeySnapshotPageCursor: "NTI1Cg==",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_blank_snapshot_filter",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "cms.urlRedirects",
+					ConfigKeySnapshotFilter: "  ",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_filter_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.contacts",
+					ConfigKeySnapshotFilter: "/blog",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_filter_unsupported_resource_in_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					ConfigKeyResources:      "cms.urlRedirects,crm.contacts",
+					ConfigKeySnapshotFilter: "/blog",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_order_by_without_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					config.KeyResource:       "crm.contacts",
+					ConfigKeySnapshot:        "false",
+					ConfigKeySnapshotOrderBy: "name",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_mode_without_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeySnapshot:     "false",
+					ConfigKeySnapshotMode: "bulk",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_checkpoint_interval_without_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:               "access_token",
+					config.KeyResource:                  "crm.contacts",
+					ConfigKeySnapshot:                   "false",
+					ConfigKeySnapshotCheckpointInterval: "10",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_resource_group",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					ConfigKeyResourceGroup: "crm.engagements",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.calls",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				ResourceGroup:              ResourceGroupEngagements,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_unknown_resource_group",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					ConfigKeyResourceGroup: "crm.objects",
 				},
 			},
 			want:    Config{},
 			wantErr: true,
 		},
 		{
-			name: "fail_invalid_polling_period",
+			name: "fail_resource_and_resource_group",
 			args: args{
 				cfg: map[string]string{
 					config.KeyAccessToken:  "access_token",
 					config.KeyResource:     "crm.contacts",
-					ConfigKeyPollingPeriod: "ten seconds",
+					ConfigKeyResourceGroup: "crm.engagements",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "crm.notes, crm.contacts,",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.notes",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				Resources:                  []string{"crm.notes", "crm.contacts"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_resource_and_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					config.KeyResource:    "crm.contacts",
+					ConfigKeyResources:    "crm.notes,crm.calls",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resources_and_resource_group",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:  "access_token",
+					ConfigKeyResourceGroup: "crm.engagements",
+					ConfigKeyResources:     "crm.notes,crm.calls",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resources_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "crm.notes,crm.unknown",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resources_duplicate_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken: "access_token",
+					ConfigKeyResources:    "crm.notes,crm.calls,crm.notes",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resources_extra_properties_not_supported",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					ConfigKeyResources:       "crm.notes,cms.blogs.authors",
+					ConfigKeyExtraProperties: "hs_note_body",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_resources_extra_properties_cdc_not_supported",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					ConfigKeyResources:          "crm.notes,cms.blogs.authors",
+					ConfigKeyExtraPropertiesCDC: "hs_note_body",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_page_cursor_resources",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:       "access_token",
+					ConfigKeyResources:          "crm.notes,crm.calls",
+					ConfigKeySnapshotPageCursor: "NTI1Cg==",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_exclude_system_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:            "access_token",
+					config.KeyResource:               "crm.contacts",
+					ConfigKeyExcludeSystemProperties: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				ExcludeSystemProperties:    true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_exclude_system_properties",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:            "access_token",
+					config.KeyResource:               "crm.contacts",
+					ConfigKeyExcludeSystemProperties: "maybe",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_include_transcript",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.calls",
+					ConfigKeyIncludeTranscript: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.calls",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				IncludeTranscript:          true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_include_transcript_unsupported_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeyIncludeTranscript: "true",
 				},
 			},
 			want:    Config{},
@@ -216,6 +1729,237 @@ func TestParseConfig(t *testing.T) {
 			want:    Config{},
 			wantErr: true,
 		},
+		{
+			name: "fail_property_allowlist_and_denylist",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeyPropertyAllowlist: "email",
+					ConfigKeyPropertyDenylist:  "hs_object_id",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_snapshot_order_by",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					config.KeyResource:       "crm.deals",
+					ConfigKeySnapshotOrderBy: " hs_deal_stage_probability ",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.deals",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				SnapshotOrderBy:            "hs_deal_stage_probability",
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_snapshot_sort_by_updated_at",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.deals",
+					ConfigKeySnapshotSortBy: "updatedAt",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.deals",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				SnapshotSortBy:             SnapshotSortByUpdatedAt,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_snapshot_sort_by",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.deals",
+					ConfigKeySnapshotSortBy: "deletedAt",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_sort_by_updated_at_timestamp_based_resource",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "cms.blogs.authors",
+					ConfigKeySnapshotSortBy: "updatedAt",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_sort_by_updated_at_and_order_by",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					config.KeyResource:       "crm.deals",
+					ConfigKeySnapshotSortBy:  "updatedAt",
+					ConfigKeySnapshotOrderBy: "dealname",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_sort_by_updated_at_bulk_snapshot_mode",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:   "access_token",
+					config.KeyResource:      "crm.deals",
+					ConfigKeySnapshotSortBy: "updatedAt",
+					ConfigKeySnapshotMode:   "bulk",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_snapshot_warning_threshold",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:             "access_token",
+					config.KeyResource:                "crm.contacts",
+					ConfigKeySnapshotWarningThreshold: "1000",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				SnapshotWarningThreshold:   1000,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_snapshot_warning_threshold",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:             "access_token",
+					config.KeyResource:                "crm.contacts",
+					ConfigKeySnapshotWarningThreshold: "many",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_negative_snapshot_warning_threshold",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:             "access_token",
+					config.KeyResource:                "crm.contacts",
+					ConfigKeySnapshotWarningThreshold: "-1",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "success_skip_empty_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeySkipEmptySnapshot: "true",
+				},
+			},
+			want: Config{
+				Config: config.Config{
+					AccessToken:        "access_token",
+					Resource:           "crm.contacts",
+					MaxRetries:         config.DefaultMaxRetries,
+					InitialRetryDelay:  config.DefaultInitialRetryDelay,
+					MaxRetryDelay:      config.DefaultMaxRetryDelay,
+					RetryOnStatusCodes: config.DefaultRetryOnStatusCodes,
+				},
+				PollingPeriod:              defaultPollingPeriod,
+				BufferSize:                 defaultBufferSize,
+				Snapshot:                   defaultSnapshot,
+				SnapshotMode:               defaultSnapshotMode,
+				RecordKeyField:             defaultRecordKeyField,
+				SnapshotCheckpointInterval: defaultSnapshotCheckpointInterval,
+				PositionFormat:             defaultPositionFormat,
+				RecordFormat:               defaultRecordFormat,
+				SkipEmptySnapshot:          true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_invalid_skip_empty_snapshot",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:      "access_token",
+					config.KeyResource:         "crm.contacts",
+					ConfigKeySkipEmptySnapshot: "nope",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
+		{
+			name: "fail_blank_snapshot_order_by",
+			args: args{
+				cfg: map[string]string{
+					config.KeyAccessToken:    "access_token",
+					config.KeyResource:       "crm.deals",
+					ConfigKeySnapshotOrderBy: "   ",
+				},
+			},
+			want:    Config{},
+			wantErr: true,
+		},
 		{
 			name: "fail_invalid_snapshot",
 			args: args{
@@ -249,3 +1993,31 @@ func TestParseConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_resources(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Config: config.Config{
+			Resource: "crm.contacts",
+		},
+	}
+
+	if got, want := cfg.resources(), []string{"crm.contacts"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("resources() = %v, want %v", got, want)
+	}
+
+	cfg.ResourceGroup = ResourceGroupEngagements
+
+	want := []string{"crm.calls", "crm.emails", "crm.meetings", "crm.notes", "crm.tasks"}
+	if got := cfg.resources(); !reflect.DeepEqual(got, want) {
+		t.Errorf("resources() = %v, want %v", got, want)
+	}
+
+	cfg.ResourceGroup = ""
+	cfg.Resources = []string{"crm.notes", "crm.contacts"}
+
+	if got, want := cfg.resources(), []string{"crm.notes", "crm.contacts"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("resources() = %v, want %v", got, want)
+	}
+}
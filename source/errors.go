@@ -0,0 +1,141 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrPropertyAllowlistAndDenylist occurs when both the property allowlist and the property denylist are set.
+	ErrPropertyAllowlistAndDenylist = errors.New("propertyAllowlist and propertyDenylist cannot be set simultaneously")
+	// ErrBlankSnapshotOrderBy occurs when the snapshotOrderBy contains only whitespaces.
+	ErrBlankSnapshotOrderBy = errors.New("snapshotOrderBy cannot be blank")
+	// ErrSourceNotOpened occurs when calling a method that requires the source to be opened before it's opened.
+	ErrSourceNotOpened = errors.New("source is not opened")
+	// ErrInvalidSnapshotMode occurs when the snapshotMode is not one of the supported values.
+	ErrInvalidSnapshotMode = errors.New(`snapshotMode must be one of "search", "bulk"`)
+	// ErrBulkSnapshotUnsupportedResource occurs when the bulk snapshot mode is used with a resource
+	// that cannot be exported.
+	ErrBulkSnapshotUnsupportedResource = errors.New("bulk snapshotMode is supported only by exportable CRM resources")
+	// ErrInvalidSnapshotSortBy occurs when the snapshotSortBy is not one of the supported values.
+	ErrInvalidSnapshotSortBy = errors.New(`snapshotSortBy must be one of "createdAt", "updatedAt"`)
+	// ErrSnapshotSortByAndOrderBy occurs when the snapshotSortBy is updatedAt and the snapshotOrderBy is set.
+	ErrSnapshotSortByAndOrderBy = errors.New("snapshotSortBy updatedAt and snapshotOrderBy cannot be set simultaneously")
+	// ErrSnapshotSortByUnsupported occurs when the snapshotSortBy is updatedAt
+	// for a resource that is not search-based or in the bulk snapshotMode.
+	ErrSnapshotSortByUnsupported = errors.New("snapshotSortBy updatedAt is supported only by search-based resources " +
+		"in the search snapshotMode")
+	// ErrInvalidResourceAlias occurs when the resourceAlias is blank or contains dots.
+	ErrInvalidResourceAlias = errors.New("resourceAlias cannot be blank or contain dots")
+	// ErrBlankRecordKeyField occurs when the recordKeyField contains only whitespaces.
+	ErrBlankRecordKeyField = errors.New("recordKeyField cannot be blank")
+	// ErrMissingConversationThreadID occurs when the conversationThreadId is not set
+	// for the conversations.messages resource.
+	ErrMissingConversationThreadID = errors.New("conversationThreadId is required for the conversations.messages resource")
+	// ErrInvalidPositionFormat occurs when the positionFormat is not one of the supported values.
+	ErrInvalidPositionFormat = errors.New(`positionFormat must be one of "json", "proto"`)
+	// ErrInvalidRecordFormat occurs when the recordFormat is not one of the supported values.
+	ErrInvalidRecordFormat = errors.New(`recordFormat must be one of "structured", "json"`)
+	// ErrIncludeTranscriptUnsupportedResource occurs when the includeTranscript is enabled for a resource other than calls.
+	ErrIncludeTranscriptUnsupportedResource = errors.New("includeTranscript is supported only by the crm.calls resource")
+	// ErrResourceAndResourceGroup occurs when both the resource and the resourceGroup are set.
+	ErrResourceAndResourceGroup = errors.New("resource and resourceGroup cannot be set simultaneously")
+	// ErrResourceAndResources occurs when both the resource and the resources are set.
+	ErrResourceAndResources = errors.New("resource and resources cannot be set simultaneously")
+	// ErrResourcesAndResourceGroup occurs when both the resources and the resourceGroup are set.
+	ErrResourcesAndResourceGroup = errors.New("resources and resourceGroup cannot be set simultaneously")
+	// ErrInvalidBlogPostState occurs when the blogPostState is not one of the supported values.
+	ErrInvalidBlogPostState = errors.New(`blogPostState must be one of "DRAFT", "SCHEDULED", "PUBLISHED"`)
+	// ErrBlogPostStateUnsupportedResource occurs when the blogPostState is set for a resource other than blog posts.
+	ErrBlogPostStateUnsupportedResource = errors.New("blogPostState is supported only by the cms.blogs.posts resource")
+	// ErrInvalidCMSPageState occurs when the cmsPageState is not one of the supported values.
+	ErrInvalidCMSPageState = errors.New(`cmsPageState must be one of "DRAFT", "PUBLISHED"`)
+	// ErrCMSPageStateUnsupportedResource occurs when the cmsPageState is set for a resource other than site pages.
+	ErrCMSPageStateUnsupportedResource = errors.New("cmsPageState is supported only by the cms.pages.site resource")
+	// ErrBlankSnapshotFilter occurs when the snapshotFilter contains only whitespaces.
+	ErrBlankSnapshotFilter = errors.New("snapshotFilter cannot be blank")
+	// ErrSnapshotFilterUnsupportedResource occurs when the snapshotFilter is set for a resource other than URL redirects.
+	ErrSnapshotFilterUnsupportedResource = errors.New("snapshotFilter is supported only by the cms.urlRedirects resource")
+	// ErrBlankSnapshotPageCursor occurs when the snapshotPageCursor contains only whitespaces.
+	ErrBlankSnapshotPageCursor = errors.New("snapshotPageCursor cannot be blank")
+	// ErrSnapshotPageCursorUnsupported occurs when the snapshotPageCursor is set together with
+	// the resourceGroup, several resources or the bulk snapshotMode.
+	ErrSnapshotPageCursorUnsupported = errors.New("snapshotPageCursor cannot be used with resourceGroup, several resources or bulk snapshotMode")
+)
+
+// ExtraPropertiesNotSupportedError occurs when the extraProperties or the extraPropertiesCdc
+// are set for a resource that doesn't support them. The Key holds the name of the option.
+type ExtraPropertiesNotSupportedError struct {
+	Key      string
+	Resource string
+}
+
+// Error returns a formated error message for the [ExtraPropertiesNotSupportedError].
+func (e *ExtraPropertiesNotSupportedError) Error() string {
+	return fmt.Sprintf("%s are supported only by CRM resources, got %q", e.Key, e.Resource)
+}
+
+// DuplicateResourceError occurs when the resources contain the same resource more than once.
+type DuplicateResourceError struct {
+	Resource string
+}
+
+// Error returns a formated error message for the [DuplicateResourceError].
+func (e *DuplicateResourceError) Error() string {
+	return fmt.Sprintf("duplicate resource %q in resources", e.Resource)
+}
+
+// UnreadableResourceError occurs when the resource cannot be read by the source.
+type UnreadableResourceError struct {
+	Resource string
+}
+
+// Error returns a formated error message for the [UnreadableResourceError].
+func (e *UnreadableResourceError) Error() string {
+	return fmt.Sprintf("resource %q cannot be read by the source", e.Resource)
+}
+
+// UnknownResourceGroupError occurs when the resourceGroup is not one of the supported resource groups.
+type UnknownResourceGroupError struct {
+	ResourceGroup string
+}
+
+// Error returns a formated error message for the [UnknownResourceGroupError].
+func (e *UnknownResourceGroupError) Error() string {
+	return fmt.Sprintf("unknown resourceGroup %q", e.ResourceGroup)
+}
+
+// SnapshotOptionWithoutSnapshotError occurs when a snapshot-only option is set while the snapshot is disabled.
+type SnapshotOptionWithoutSnapshotError struct {
+	Key string
+}
+
+// Error returns a formated error message for the [SnapshotOptionWithoutSnapshotError].
+func (e *SnapshotOptionWithoutSnapshotError) Error() string {
+	return fmt.Sprintf("%s cannot be set if snapshot is disabled", e.Key)
+}
+
+// InvalidPropertyError occurs when a requested extra property doesn't exist on a HubSpot resource.
+type InvalidPropertyError struct {
+	Name     string
+	Resource string
+}
+
+// Error returns a formated error message for the [InvalidPropertyError].
+func (e *InvalidPropertyError) Error() string {
+	return fmt.Sprintf("property %q doesn't exist on resource %q", e.Name, e.Resource)
+}
@@ -0,0 +1,69 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// send sends a record to the records buffer. If the buffer stays full longer than the maxWait,
+// the function returns the [ErrBufferTimeout], so that a slow consumer doesn't block polling indefinitely.
+// If the maxWait is zero, the function blocks until the record is sent or the context is done.
+func send(ctx context.Context, records chan<- opencdc.Record, record opencdc.Record, maxWait time.Duration) error {
+	// the buffer is usually not full, so the timer is created only if the record cannot be sent right away.
+	select {
+	case records <- record:
+		return nil
+
+	default:
+	}
+
+	var timeout <-chan time.Time
+	if maxWait > 0 {
+		timer := time.NewTimer(maxWait)
+		defer timer.Stop()
+
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context cancelled: %w", ctx.Err())
+
+	case <-timeout:
+		return ErrBufferTimeout
+
+	case records <- record:
+		return nil
+	}
+}
+
+// drain drops the records left in the records buffer and releases a slot of the semaphore for each of them,
+// because the dropped records are never returned, so they won't be acknowledged.
+func drain(records chan opencdc.Record, semaphore chan struct{}) {
+	for {
+		select {
+		case <-records:
+			release(semaphore)
+
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		full    bool
+		maxWait time.Duration
+		wantErr error
+	}{
+		{
+			name:    "success",
+			maxWait: time.Millisecond,
+		},
+		{
+			name:    "success_without_max_wait",
+			maxWait: 0,
+		},
+		{
+			name:    "fail_buffer_timeout",
+			full:    true,
+			maxWait: time.Millisecond * 10,
+			wantErr: ErrBufferTimeout,
+		},
+		{
+			name:    "fail_context_cancelled_without_max_wait",
+			full:    true,
+			maxWait: 0,
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+			t.Cleanup(cancel)
+
+			records := make(chan opencdc.Record, 1)
+			if tt.full {
+				// nobody reads the records, so the buffer stays full.
+				records <- opencdc.Record{}
+			}
+
+			err := send(ctx, records, opencdc.Record{Position: opencdc.Position("1")}, tt.maxWait)
+			if tt.wantErr == nil {
+				is.NoErr(err)
+				is.Equal((<-records).Position, opencdc.Position("1"))
+
+				return
+			}
+
+			is.True(errors.Is(err, tt.wantErr))
+		})
+	}
+}
@@ -17,6 +17,8 @@ package iterator
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
@@ -24,41 +26,152 @@ import (
 	sdk "github.com/conduitio/conduit-connector-sdk"
 )
 
+const (
+	// archivedFieldName is a name of the field that indicates whether an item is archived or not.
+	archivedFieldName = "archived"
+	// archivedAtFieldName is a name of the field that holds the date when an item was archived.
+	archivedAtFieldName = "archivedAt"
+	// maxArchivedPages is the maximum number of pages of archived items listed per poll.
+	maxArchivedPages = 10
+)
+
 // CDC is an implementation of a CDC iterator for the HubSpot API.
 type CDC struct {
-	hubspotClient   *hubspot.Client
-	resource        string
-	bufferSize      int
-	pollingPeriod   time.Duration
+	hubspotClient hubspot.ClientInterface
+	resource      string
+	// resourceAlias replaces the resource in the collection metadata if it's not empty.
+	resourceAlias string
+	// recordKeyField is the item's field which value becomes the record key.
+	recordKeyField string
+	// conversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	conversationThreadID string
+	bufferSize           int
+	pollingPeriod        time.Duration
+	// pollingJitter is an upper bound of a random delay added to each polling period.
+	pollingJitter   time.Duration
 	records         chan opencdc.Record
 	errC            chan error
 	stopC           chan struct{}
 	position        *Position
 	extraProperties []string
+	// propertyAllowlist holds properties that will be kept in items.
+	propertyAllowlist []string
+	// propertyDenylist holds properties that will be removed from items.
+	propertyDenylist []string
+	// excludeSystemProperties determines whether the HubSpot system properties are removed from items.
+	excludeSystemProperties bool
+	// semaphore limits the number of unacknowledged records.
+	semaphore chan struct{}
+	// binaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	binaryPosition bool
+	// rawPayload determines whether record payloads are JSON-encoded raw data instead of structured data.
+	rawPayload bool
+	// includeTranscript determines whether the transcripts of calls are added to their properties.
+	includeTranscript bool
+	// maxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	maxBufferWait time.Duration
+	// truncationWarner determines whether a warning is logged when a search may be truncated.
+	truncationWarner bool
+	// dedupWindow is the duration within which the same update of an item is emitted only once.
+	dedupWindow time.Duration
+	// dedupSeen holds the emitted updates keyed by the item's id, it's used only if the dedupWindow is set.
+	dedupSeen map[string]dedupEntry
+	// metrics holds the source counters, the iterator updates the last poll duration.
+	metrics *Metrics
+}
+
+// dedupEntry is an update of an item that's emitted by the [CDC] iterator.
+type dedupEntry struct {
+	// updatedAt is the item's update date.
+	updatedAt time.Time
+	// seenAt is the time the update was emitted.
+	seenAt time.Time
 }
 
 // CDCParams is an incoming params for the [NewCDC] function.
 type CDCParams struct {
-	HubSpotClient   *hubspot.Client
-	Resource        string
-	BufferSize      int
-	PollingPeriod   time.Duration
+	HubSpotClient hubspot.ClientInterface
+	Resource      string
+	// ResourceAlias replaces the Resource in the collection metadata if it's not empty.
+	ResourceAlias string
+	// RecordKeyField is the item's field which value becomes the record key.
+	// If it's empty, the item's id is used.
+	RecordKeyField string
+	// ConversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	ConversationThreadID string
+	BufferSize           int
+	PollingPeriod        time.Duration
+	// PollingJitter is an upper bound of a random delay added to each polling period.
+	PollingJitter   time.Duration
 	Position        *Position
 	ExtraProperties []string
+	// CDCExtraProperties overrides the ExtraProperties if it's not empty.
+	CDCExtraProperties []string
+	PropertyAllowlist  []string
+	PropertyDenylist   []string
+	// ExcludeSystemProperties determines whether the HubSpot system properties, prefixed with hs_, are removed from items.
+	ExcludeSystemProperties bool
+	Semaphore               chan struct{}
+	// BinaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	BinaryPosition bool
+	// RawPayload determines whether record payloads are JSON-encoded [opencdc.RawData]
+	// instead of [opencdc.StructuredData].
+	RawPayload bool
+	// IncludeTranscript determines whether the transcripts of calls are added to their properties
+	// as the transcript property. It's ignored by resources other than crm.calls.
+	IncludeTranscript bool
+	// MaxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	// If it's zero, the iterator waits until the record is read.
+	MaxBufferWait time.Duration
+	// CDCTruncationWarner determines whether a warning is logged when a search returns as many items
+	// as the BufferSize, which means that some updates may be left for the next polls.
+	// The Combined iterator always enables it.
+	CDCTruncationWarner bool
+	// DedupWindow is the duration within which updates of an item with the same update date
+	// are emitted only once, as HubSpot may return the same update several times.
+	// If it's zero, the deduplication is disabled.
+	DedupWindow time.Duration
+	// Metrics is an optional set of counters, the iterator updates the last poll duration.
+	Metrics *Metrics
 }
 
 // NewCDC creates a new instance of the [CDC].
 func NewCDC(ctx context.Context, params CDCParams) (*CDC, error) {
 	cdc := &CDC{
-		hubspotClient:   params.HubSpotClient,
-		resource:        params.Resource,
-		bufferSize:      params.BufferSize,
-		pollingPeriod:   params.PollingPeriod,
-		records:         make(chan opencdc.Record, params.BufferSize),
-		errC:            make(chan error, 1),
-		stopC:           make(chan struct{}, 1),
-		position:        params.Position,
-		extraProperties: params.ExtraProperties,
+		hubspotClient:           params.HubSpotClient,
+		resource:                params.Resource,
+		resourceAlias:           params.ResourceAlias,
+		recordKeyField:          params.RecordKeyField,
+		conversationThreadID:    params.ConversationThreadID,
+		bufferSize:              params.BufferSize,
+		pollingPeriod:           params.PollingPeriod,
+		pollingJitter:           params.PollingJitter,
+		records:                 make(chan opencdc.Record, params.BufferSize),
+		errC:                    make(chan error, 1),
+		stopC:                   make(chan struct{}, 1),
+		position:                params.Position,
+		extraProperties:         params.ExtraProperties,
+		propertyAllowlist:       params.PropertyAllowlist,
+		propertyDenylist:        params.PropertyDenylist,
+		excludeSystemProperties: params.ExcludeSystemProperties,
+		semaphore:               params.Semaphore,
+		binaryPosition:          params.BinaryPosition,
+		rawPayload:              params.RawPayload,
+		maxBufferWait:           params.MaxBufferWait,
+		truncationWarner:        params.CDCTruncationWarner,
+		dedupWindow:             params.DedupWindow,
+		metrics:                 params.Metrics,
+	}
+
+	// the multi-resource iterator shares the params between resources, so the resource is checked here.
+	cdc.includeTranscript = params.IncludeTranscript && params.Resource == hubspot.CallsResource
+
+	if cdc.dedupWindow > 0 {
+		cdc.dedupSeen = make(map[string]dedupEntry)
+	}
+
+	if len(params.CDCExtraProperties) > 0 {
+		cdc.extraProperties = params.CDCExtraProperties
 	}
 
 	if cdc.position == nil || cdc.position.Timestamp == nil {
@@ -69,6 +182,16 @@ func NewCDC(ctx context.Context, params CDCParams) (*CDC, error) {
 		}
 	}
 
+	if err := cdc.position.Validate(); err != nil {
+		return nil, fmt.Errorf("validate position: %w", err)
+	}
+
+	// the archived items are tracked from the position's timestamp until the first of them is emitted.
+	if cdc.position.ArchivedTimestamp == nil {
+		archivedTimestamp := *cdc.position.Timestamp
+		cdc.position.ArchivedTimestamp = &archivedTimestamp
+	}
+
 	if err := cdc.loadRecords(ctx); err != nil {
 		return nil, fmt.Errorf("initial load record: %w", err)
 	}
@@ -97,14 +220,21 @@ func (c *CDC) Next(ctx context.Context) (opencdc.Record, error) {
 	}
 }
 
+// Len returns the number of records that are loaded and wait to be returned.
+func (c *CDC) Len() int {
+	return len(c.records)
+}
+
 // Stop stops the iterator.
 func (c *CDC) Stop() {
 	c.stopC <- struct{}{}
 }
 
-// poll polls items at the specified time intervals.
+// poll polls items at the specified time intervals,
+// each of them is extended by a random jitter if the pollingJitter is set.
 func (c *CDC) poll(ctx context.Context) {
-	ticker := time.NewTicker(c.pollingPeriod)
+	timer := time.NewTimer(pollingInterval(c.pollingPeriod, c.pollingJitter))
+	defer timer.Stop()
 
 	for {
 		select {
@@ -114,10 +244,12 @@ func (c *CDC) poll(ctx context.Context) {
 		case <-c.stopC:
 			return
 
-		case <-ticker.C:
+		case <-timer.C:
 			if err := c.loadRecords(ctx); err != nil {
 				c.errC <- fmt.Errorf("load records: %w", err)
 			}
+
+			timer.Reset(pollingInterval(c.pollingPeriod, c.pollingJitter))
 		}
 	}
 }
@@ -125,6 +257,10 @@ func (c *CDC) poll(ctx context.Context) {
 // loadRecords loads HubSpot items using timestamp-based filtering and search endpoints.
 // The method tries to retrieve items filtering them by updatedAfter or lastmodifieddate.
 func (c *CDC) loadRecords(ctx context.Context) error {
+	defer c.metrics.observePoll(time.Now())
+
+	c.evictDedupEntries(time.Now())
+
 	// add a millisecond here in order to skip the processed item
 	*c.position.Timestamp = c.position.Timestamp.Add(time.Millisecond)
 
@@ -137,12 +273,31 @@ func (c *CDC) loadRecords(ctx context.Context) error {
 
 // processUpdatedItems retrieves items that were updated after the provided timestamp.
 func (c *CDC) processUpdatedItems(ctx context.Context, updatedAfter time.Time) error {
+	// the thread messages cannot be filtered by date, so they're paged by the after cursor instead.
+	if c.resource == hubspot.ConversationMessagesResource {
+		return c.fetchCursorBasedItems(ctx, hubspot.TimestampResources[c.resource], updatedAfter)
+	}
+
 	if timestampResource, ok := hubspot.TimestampResources[c.resource]; ok {
 		return c.fetchTimestampBasedItems(ctx, timestampResource, updatedAfter)
 	}
 
 	if searchResource, ok := hubspot.SearchResources[c.resource]; ok {
-		return c.fetchSearchBasedItems(ctx, searchResource, updatedAfter)
+		// seen holds ids of items that are already emitted within the current poll cycle.
+		seen := make(map[string]bool)
+
+		if err := c.fetchSearchBasedItems(ctx, searchResource, updatedAfter, seen); err != nil {
+			return err
+		}
+
+		// a poll emits at most bufferSize records, so the archived items fill the rest of the buffer,
+		// and if the search is truncated, they're left for the next polls.
+		limit := c.bufferSize - len(seen)
+		if limit <= 0 {
+			return nil
+		}
+
+		return c.fetchArchivedItems(ctx, searchResource, limit, seen)
 	}
 
 	return nil
@@ -167,7 +322,7 @@ func (c *CDC) fetchTimestampBasedItems(
 	}
 
 	for _, item := range listResponse.Results {
-		err = c.routeItem(item,
+		err = c.routeItem(ctx, item,
 			resource.CreatedAtFieldName,
 			resource.UpdatedAtFieldName,
 			resource.DeletedAtFieldName,
@@ -181,11 +336,61 @@ func (c *CDC) fetchTimestampBasedItems(
 	return nil
 }
 
+// fetchCursorBasedItems fetches items of a resource which list endpoint supports only the after cursor.
+// The pages are listed starting from the position's cursor, and the items created before the createdAfter
+// are skipped. The listing stops at the first page with new items, so that at most bufferSize records
+// are sent per poll, and the cursor stays on that page, as the items can be appended to the last one.
+func (c *CDC) fetchCursorBasedItems(
+	ctx context.Context,
+	resource hubspot.TimestampResource,
+	createdAfter time.Time,
+) error {
+	listOpts := &hubspot.ListOptions{
+		Limit: c.bufferSize,
+		After: c.position.After,
+	}
+
+	for {
+		listResponse, err := c.hubspotClient.GetConversationMessages(ctx, c.conversationThreadID, listOpts)
+		if err != nil {
+			return fmt.Errorf("list items: %w", err)
+		}
+
+		var sent int
+		for _, item := range listResponse.Results {
+			itemCreatedAt, err := item.GetTimeField(resource.CreatedAtFieldName)
+			if err != nil {
+				return fmt.Errorf("get item's creation date: %w", err)
+			}
+
+			if itemCreatedAt.Before(createdAfter) {
+				continue
+			}
+
+			// the items are routed by their creation dates, as only new items are found by the cursor.
+			err = c.routeItem(ctx, item, resource.CreatedAtFieldName, resource.CreatedAtFieldName, "", createdAfter)
+			if err != nil {
+				return fmt.Errorf("route cursor based item: %w", err)
+			}
+
+			sent++
+		}
+
+		if sent > 0 || !listResponse.HasMore() {
+			return nil
+		}
+
+		listOpts.After = listResponse.NextAfter()
+		c.position.After = listOpts.After
+	}
+}
+
 // fetchSearchBasedItems fetches items using search endpoint.
 func (c *CDC) fetchSearchBasedItems(
 	ctx context.Context,
 	resource hubspot.SearchResource,
 	updatedAfter time.Time,
+	seen map[string]bool,
 ) error {
 	listResponse, err := c.hubspotClient.SearchByUpdatedAfter(
 		ctx, c.resource, updatedAfter, c.bufferSize, c.extraProperties,
@@ -194,19 +399,205 @@ func (c *CDC) fetchSearchBasedItems(
 		return fmt.Errorf("list items: %w", err)
 	}
 
+	if c.truncationWarner && len(listResponse.Results) == c.bufferSize {
+		sdk.Logger(ctx).Warn().
+			Str("resource", c.resource).
+			Time("updatedAfter", updatedAfter).
+			Int("bufferSize", c.bufferSize).
+			Msg("the search returned as many items as the buffer size, so the results may be truncated")
+	}
+
 	for _, item := range listResponse.Results {
-		err = c.routeItem(item, resource.CreatedAtFieldName, resource.UpdatedAtFieldName, "", updatedAfter)
+		err = c.routeItem(ctx, item, resource.CreatedAtFieldName, resource.UpdatedAtFieldName, "", updatedAfter)
 		if err != nil {
 			return fmt.Errorf("route search based item: %w", err)
 		}
+
+		seen[c.position.ItemID] = true
 	}
 
 	return nil
 }
 
+// fetchArchivedItems fetches archived items of a search-based resource, because the search endpoint
+// doesn't return them, and sends a Delete opencdc.Record for each of them.
+// The list endpoint doesn't filter archived items by date, so at most maxArchivedPages pages are listed,
+// and only the items archived after the position's archived cursor are kept.
+// They're emitted in the order of their archive dates, at most limit of them per poll,
+// and the items that are already emitted within the current poll cycle are skipped.
+func (c *CDC) fetchArchivedItems(
+	ctx context.Context,
+	resource hubspot.SearchResource,
+	limit int,
+	seen map[string]bool,
+) error {
+	listOpts := &hubspot.ListOptions{
+		Limit:    c.bufferSize,
+		Archived: true,
+	}
+
+	var items []archivedItem
+	for page := 1; ; page++ {
+		listResponse, err := c.hubspotClient.List(ctx, c.resource, listOpts)
+		if err != nil {
+			return fmt.Errorf("list archived items: %w", err)
+		}
+
+		for _, item := range listResponse.Results {
+			if archived, _ := item[archivedFieldName].(bool); !archived {
+				continue
+			}
+
+			itemID, ok := item[hubspot.ResultsFieldID].(string)
+			if !ok {
+				// this shouldn't happen cause HubSpot API v3 returns items with string identifiers.
+				return ErrItemIDIsNotAString
+			}
+
+			if seen[itemID] {
+				continue
+			}
+
+			itemArchivedAt, err := getArchivedAt(item, resource)
+			if err != nil {
+				return fmt.Errorf("get item's archive date: %w", err)
+			}
+
+			if !c.isArchivedAfterPosition(itemID, itemArchivedAt) {
+				continue
+			}
+
+			items = append(items, archivedItem{
+				item:       item,
+				id:         itemID,
+				archivedAt: itemArchivedAt,
+			})
+		}
+
+		if !listResponse.HasMore() {
+			break
+		}
+
+		if page == maxArchivedPages {
+			sdk.Logger(ctx).Warn().
+				Str("resource", c.resource).
+				Int("maxArchivedPages", maxArchivedPages).
+				Msg("the archived items have more pages than listed per poll, so some deletes may be missed")
+
+			break
+		}
+
+		listOpts.After = listResponse.NextAfter()
+	}
+
+	slices.SortFunc(items, func(a, b archivedItem) int {
+		if n := a.archivedAt.Compare(b.archivedAt); n != 0 {
+			return n
+		}
+
+		return strings.Compare(a.id, b.id)
+	})
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	for _, item := range items {
+		if err := c.routeArchivedItem(ctx, item, resource); err != nil {
+			return fmt.Errorf("route archived item: %w", err)
+		}
+
+		seen[item.id] = true
+	}
+
+	return nil
+}
+
+// archivedItem is an archived item with its id and archive date.
+type archivedItem struct {
+	item       hubspot.ListResponseResult
+	id         string
+	archivedAt time.Time
+}
+
+// isArchivedAfterPosition checks whether an item was archived after the position's archived cursor.
+// The items archived at the same time as the cursor are compared by their ids.
+func (c *CDC) isArchivedAfterPosition(itemID string, itemArchivedAt time.Time) bool {
+	if c.position.ArchivedTimestamp == nil {
+		return true
+	}
+
+	if n := itemArchivedAt.Compare(*c.position.ArchivedTimestamp); n != 0 {
+		return n > 0
+	}
+
+	return itemID > c.position.ArchivedItemID
+}
+
+// getArchivedAt returns the date when the item was archived.
+// If the item doesn't hold the archivedAt field, its updatedAt is returned.
+func getArchivedAt(item hubspot.ListResponseResult, resource hubspot.SearchResource) (time.Time, error) {
+	if _, ok := item[archivedAtFieldName]; ok {
+		return item.GetTimeField(archivedAtFieldName)
+	}
+
+	return item.GetTimeField(resource.UpdatedAtFieldName)
+}
+
+// routeArchivedItem sends a Delete opencdc.Record for the archived item.
+// Only the position's archived cursor is moved to the item, the timestamp of updated items is left as is,
+// so that the updates that are not retrieved yet will not be skipped.
+func (c *CDC) routeArchivedItem(
+	ctx context.Context,
+	item archivedItem,
+	resource hubspot.SearchResource,
+) error {
+	itemCreatedAt, err := item.item.GetTimeField(resource.CreatedAtFieldName)
+	if err != nil {
+		return fmt.Errorf("get item's creation date: %w", err)
+	}
+
+	metadata := c.getItemMetadata(itemCreatedAt)
+
+	position := c.position.clone()
+	position.ArchivedTimestamp = &item.archivedAt
+	position.ArchivedItemID = item.id
+	c.position = position
+
+	sdkPosition, err := c.position.marshalSDKPosition(c.binaryPosition)
+	if err != nil {
+		return fmt.Errorf("marshal sdk position: %w", err)
+	}
+
+	key, err := newRecordKey(item.item, c.recordKeyField, item.id)
+	if err != nil {
+		return fmt.Errorf("get item's key: %w", err)
+	}
+
+	if err := acquire(ctx, c.semaphore, c.maxBufferWait); err != nil {
+		return fmt.Errorf("acquire semaphore: %w", err)
+	}
+
+	record := sdk.Util.Source.NewRecordDelete(sdkPosition, metadata, key, nil)
+
+	if err := send(ctx, c.records, record, c.maxBufferWait); err != nil {
+		release(c.semaphore)
+
+		return fmt.Errorf("send record: %w", err)
+	}
+
+	return nil
+}
+
+// getItemMetadata constructs a [opencdc.Metadata] based on an item's createdAt and the resource.
+func (c *CDC) getItemMetadata(createdAt time.Time) opencdc.Metadata {
+	return newMetadata(c.resource, c.resourceAlias, createdAt)
+}
+
 // routeItem retrives createdAt and updatedAt fields from the item, compares them
 // and based on the result of the comparison decides to send a Create or Update opencdc.Record.
 func (c *CDC) routeItem(
+	ctx context.Context,
 	item hubspot.ListResponseResult,
 	createdAtFieldName,
 	updatedAtFieldName,
@@ -231,8 +622,13 @@ func (c *CDC) routeItem(
 		}
 	}
 
-	metadata := make(opencdc.Metadata)
-	metadata.SetCreatedAt(itemCreatedAt)
+	if c.isDuplicate(item, itemUpdatedAt, time.Now()) {
+		sdk.Logger(ctx).Debug().Any("id", item[hubspot.ResultsFieldID]).Msg("skipping duplicated update of the item")
+
+		return nil
+	}
+
+	metadata := c.getItemMetadata(itemCreatedAt)
 
 	// set the timestamp to the item's updatedAt
 	// as we sort items by their updatedAt values.
@@ -241,52 +637,117 @@ func (c *CDC) routeItem(
 		return fmt.Errorf("get item's position: %w", err)
 	}
 
-	sdkPosition, err := c.position.MarshalSDKPosition()
+	sdkPosition, err := c.position.marshalSDKPosition(c.binaryPosition)
 	if err != nil {
 		return fmt.Errorf("marshal sdk position: %w", err)
 	}
 
-	c.records <- c.getRecord(
-		item, itemCreatedAt, itemDeletedAt, updatedAfter,
-		sdkPosition, metadata,
+	key, err := newRecordKey(item, c.recordKeyField, c.position.ItemID)
+	if err != nil {
+		return fmt.Errorf("get item's key: %w", err)
+	}
+
+	record, err := c.getRecord(
+		ctx, item, itemCreatedAt, itemDeletedAt, updatedAfter,
+		sdkPosition, metadata, key,
 	)
+	if err != nil {
+		return fmt.Errorf("get record: %w", err)
+	}
+
+	if err := acquire(ctx, c.semaphore, c.maxBufferWait); err != nil {
+		return fmt.Errorf("acquire semaphore: %w", err)
+	}
+
+	if err := send(ctx, c.records, record, c.maxBufferWait); err != nil {
+		release(c.semaphore)
+
+		return fmt.Errorf("send record: %w", err)
+	}
 
 	return nil
 }
 
+// isDuplicate checks whether the same update of the item has already been emitted within the dedupWindow.
+// If it hasn't, the update is remembered, so that its next occurrences are skipped.
+// The method always returns false if the dedupWindow is not set.
+func (c *CDC) isDuplicate(item hubspot.ListResponseResult, updatedAt, now time.Time) bool {
+	if c.dedupWindow <= 0 {
+		return false
+	}
+
+	itemID, ok := item[hubspot.ResultsFieldID].(string)
+	if !ok {
+		return false
+	}
+
+	entry, ok := c.dedupSeen[itemID]
+	if ok && entry.updatedAt.Equal(updatedAt) && now.Sub(entry.seenAt) < c.dedupWindow {
+		return true
+	}
+
+	c.dedupSeen[itemID] = dedupEntry{
+		updatedAt: updatedAt,
+		seenAt:    now,
+	}
+
+	return false
+}
+
+// evictDedupEntries removes the updates that were emitted earlier than the dedupWindow ago.
+func (c *CDC) evictDedupEntries(now time.Time) {
+	for itemID, entry := range c.dedupSeen {
+		if now.Sub(entry.seenAt) >= c.dedupWindow {
+			delete(c.dedupSeen, itemID)
+		}
+	}
+}
+
 // getRecord generates a record choosing the operation type based on provided arguments.
-func (c *CDC) getRecord(item hubspot.ListResponseResult,
+func (c *CDC) getRecord(
+	ctx context.Context,
+	item hubspot.ListResponseResult,
 	itemCreatedAt,
 	itemDeletedAt,
 	updatedAfter time.Time,
 	sdkPosition opencdc.Position,
 	metadata opencdc.Metadata,
-) opencdc.Record {
+	key opencdc.StructuredData,
+) (opencdc.Record, error) {
 	// if an item is not deleted the HubSpot returns the deletedAt field value
 	// equal to Unix Epoch (1970-01-01T00:00:00Z).
 	// So if the itemDeletedAt.Unix() is not equal to 0, than the item is deleted.
 	if itemDeletedAt.Unix() > 0 {
-		return sdk.Util.Source.NewRecordDelete(sdkPosition, metadata,
-			opencdc.StructuredData{hubspot.ResultsFieldID: c.position.ItemID}, nil,
-		)
+		return sdk.Util.Source.NewRecordDelete(sdkPosition, metadata, key, nil), nil
+	}
+
+	item = filterProperties(item, c.propertyAllowlist, c.propertyDenylist)
+	if c.excludeSystemProperties {
+		item = removeSystemProperties(item)
+	}
+
+	if c.includeTranscript {
+		if err := addCallTranscript(ctx, c.hubspotClient, item); err != nil {
+			return opencdc.Record{}, fmt.Errorf("add call's transcript: %w", err)
+		}
+	}
+
+	payload, err := newRecordPayload(item, c.rawPayload)
+	if err != nil {
+		return opencdc.Record{}, fmt.Errorf("get item's payload: %w", err)
 	}
 
 	// if the item's createdAt is after the timestamp after which we're searching items
 	// we consider the item's operation to be opencdc.OperationCreate.
 	if itemCreatedAt.After(updatedAfter) {
-		return sdk.Util.Source.NewRecordCreate(sdkPosition, metadata,
-			opencdc.StructuredData{hubspot.ResultsFieldID: c.position.ItemID},
-			opencdc.StructuredData(item),
-		)
+		return sdk.Util.Source.NewRecordCreate(sdkPosition, metadata, key, payload), nil
 	}
 
-	return sdk.Util.Source.NewRecordUpdate(sdkPosition, metadata,
-		opencdc.StructuredData{hubspot.ResultsFieldID: c.position.ItemID},
-		nil, opencdc.StructuredData(item),
-	)
+	return sdk.Util.Source.NewRecordUpdate(sdkPosition, metadata, key, nil, payload), nil
 }
 
 // getItemPosition grabs an id field from a provided item and constructs a [Position] based on its value.
+// The page cursor and the archived cursor are carried over from the current position.
 func (c *CDC) getItemPosition(item map[string]any, timestamp time.Time) (*Position, error) {
 	itemID, ok := item[hubspot.ResultsFieldID].(string)
 	if !ok {
@@ -295,8 +756,11 @@ func (c *CDC) getItemPosition(item map[string]any, timestamp time.Time) (*Positi
 	}
 
 	return &Position{
-		Mode:      CDCPositionMode,
-		ItemID:    itemID,
-		Timestamp: &timestamp,
+		Mode:              CDCPositionMode,
+		ItemID:            itemID,
+		After:             c.position.After,
+		Timestamp:         &timestamp,
+		ArchivedTimestamp: c.position.ArchivedTimestamp,
+		ArchivedItemID:    c.position.ArchivedItemID,
 	}, nil
 }
@@ -0,0 +1,851 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot/mock"
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+// testCDCSearchResponse is a search response that contains a newly created contact
+// and a contact that was created before and updated after 2022-10-28T12:00:00Z.
+const testCDCSearchResponse = `{"total": 2, "results": [` +
+	`{"id": "1", "createdAt": "2022-10-28T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", ` +
+	`"properties": {"email": "bob@example.com", "hs_object_id": "1"}}, ` +
+	`{"id": "2", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T14:00:00Z", ` +
+	`"properties": {"email": "alice@example.com", "hs_object_id": "2"}}]}`
+
+// testCDCEmptyListResponse is a list response that contains no items.
+const testCDCEmptyListResponse = `{"results": []}`
+
+// expectCDCSearch sets up the mock client to return the searchResponse for the search
+// of updated contacts and the archivedResponse for the list of archived ones.
+func expectCDCSearch(t *testing.T, client *mock.MockClientInterface, searchResponse, archivedResponse string) {
+	t.Helper()
+
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, searchResponse), nil)
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		Return(testListResponse(t, archivedResponse), nil)
+}
+
+func TestCDC_Next_excludeSystemProperties(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+		ExcludeSystemProperties: true,
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "bob@example.com"})
+
+	record, err = cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationUpdate)
+
+	payload, ok = record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "alice@example.com"})
+}
+
+func TestCDC_Next_rawPayload(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+		RawPayload: true,
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	for _, want := range []struct {
+		operation opencdc.Operation
+		item      map[string]any
+	}{
+		{
+			operation: opencdc.OperationCreate,
+			item: map[string]any{
+				"id":         "1",
+				"createdAt":  "2022-10-28T13:00:00Z",
+				"updatedAt":  "2022-10-28T13:00:00Z",
+				"properties": map[string]any{"email": "bob@example.com", "hs_object_id": "1"},
+			},
+		},
+		{
+			operation: opencdc.OperationUpdate,
+			item: map[string]any{
+				"id":         "2",
+				"createdAt":  "2022-10-27T13:00:00Z",
+				"updatedAt":  "2022-10-28T14:00:00Z",
+				"properties": map[string]any{"email": "alice@example.com", "hs_object_id": "2"},
+			},
+		},
+	} {
+		record, err := cdc.Next(ctx)
+		is.NoErr(err)
+
+		is.Equal(record.Operation, want.operation)
+		is.Equal(record.Key, opencdc.StructuredData{"id": want.item["id"]})
+
+		payload, ok := record.Payload.After.(opencdc.RawData)
+		is.True(ok)
+
+		var item map[string]any
+		is.NoErr(json.Unmarshal(payload, &item))
+		is.Equal(item, want.item)
+	}
+}
+
+func TestCDC_Next_includeTranscript(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.calls", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, `{"total": 1, "results": [`+
+			`{"id": "1", "createdAt": "2022-10-28T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", `+
+			`"properties": {"hs_call_title": "Discovery call"}}]}`), nil)
+	client.EXPECT().
+		List(gomock.Any(), "crm.calls", gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil)
+	client.EXPECT().GetCallTranscript(gomock.Any(), "1").Return("Hello, this is Bob.", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.calls",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+		IncludeTranscript: true,
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{
+		"hs_call_title": "Discovery call",
+		"transcript":    "Hello, this is Bob.",
+	})
+}
+
+func TestCDC_Next_propertyDenylist(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+		PropertyDenylist: []string{"hs_object_id"},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "bob@example.com"})
+
+	record, err = cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationUpdate)
+
+	payload, ok = record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "alice@example.com"})
+}
+
+func TestCDC_Next_emptyPropertyDenylist(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload, opencdc.StructuredData{
+		"id":        "1",
+		"createdAt": "2022-10-28T13:00:00Z",
+		"updatedAt": "2022-10-28T13:00:00Z",
+		"properties": map[string]any{
+			"email":        "bob@example.com",
+			"hs_object_id": "1",
+		},
+	})
+}
+
+func TestCDC_Next_cdcExtraProperties(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the cdc extra properties are requested instead of the extra ones.
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, []string{"phone"}).
+		Return(testListResponse(t, testCDCSearchResponse), nil)
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+		ExtraProperties:    []string{"firstname"},
+		CDCExtraProperties: []string{"phone"},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	_, err = cdc.Next(ctx)
+	is.NoErr(err)
+}
+
+func TestCDC_Next_archivedItems(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, `{"total": 1, "results": [`+
+			`{"id": "1", "createdAt": "2022-10-28T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", `+
+			`"properties": {"email": "bob@example.com"}}]}`), nil)
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			if !opts.Archived {
+				t.Errorf("expected archived items to be requested")
+			}
+
+			return testListResponse(t, `{"results": [`+
+				`{"id": "1", "createdAt": "2022-10-28T13:00:00Z", "updatedAt": "2022-10-28T13:30:00Z", "archived": true}, `+
+				`{"id": "3", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T14:00:00Z", "archived": true}, `+
+				`{"id": "4", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T15:00:00Z", "archived": false}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+
+	record, err = cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationDelete)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "3"})
+
+	hasNext, err := cdc.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+}
+
+func TestCDC_Next_archivedItemsNextPoll(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil).
+		Times(2)
+
+	// the list isn't filtered by the update date, so both polls return the same pages,
+	// including the contact 5, which was archived before the position.
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			if opts.After == "" {
+				return testListResponse(t, `{"results": [`+
+					`{"id": "5", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T11:00:00Z", `+
+					`"archivedAt": "2022-10-28T11:00:00Z", "archived": true}, `+
+					`{"id": "3", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", `+
+					`"archivedAt": "2022-10-28T14:00:00Z", "archived": true}], `+
+					`"paging": {"next": {"after": "2"}}}`), nil
+			}
+
+			return testListResponse(t, `{"results": [`+
+				`{"id": "6", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T15:00:00Z", "archived": true}]}`), nil
+		}).
+		Times(4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	for _, wantID := range []string{"3", "6"} {
+		record, err := cdc.Next(ctx)
+		is.NoErr(err)
+
+		is.Equal(record.Operation, opencdc.OperationDelete)
+		is.Equal(record.Key, opencdc.StructuredData{"id": wantID})
+	}
+
+	// the second poll doesn't emit the deletes again.
+	is.NoErr(cdc.loadRecords(ctx))
+
+	hasNext, err := cdc.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+}
+
+func TestCDC_Next_archivedItemsTruncatedSearch(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+	lastUpdatedAt := time.Date(2022, 10, 28, 13, 30, 0, 0, time.UTC)
+	archivedAt := time.Date(2022, 10, 28, 15, 0, 0, 0, time.UTC)
+
+	// the first search returns as many items as the buffer size, so the updates after the contact 2
+	// and the contact 3, archived later than them, are left for the next poll,
+	// which must continue from the last update rather than from the archive date.
+	gomock.InOrder(
+		client.EXPECT().
+			SearchByUpdatedAfter(gomock.Any(), "crm.contacts", timestamp.Add(time.Millisecond), 2, gomock.Any()).
+			Return(testListResponse(t, `{"total": 3, "results": [`+
+				`{"id": "1", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z"}, `+
+				`{"id": "2", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:30:00Z"}]}`), nil),
+		client.EXPECT().
+			SearchByUpdatedAfter(gomock.Any(), "crm.contacts", lastUpdatedAt.Add(time.Millisecond), 2, gomock.Any()).
+			Return(testListResponse(t, `{"total": 1, "results": [`+
+				`{"id": "4", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T14:00:00Z"}]}`), nil),
+	)
+
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		Return(testListResponse(t, `{"results": [`+
+			`{"id": "3", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T15:00:00Z", `+
+			`"archivedAt": "2022-10-28T15:00:00Z", "archived": true}]}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    2,
+		PollingPeriod: time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	for _, wantID := range []string{"1", "2"} {
+		record, err := cdc.Next(ctx)
+		is.NoErr(err)
+
+		is.Equal(record.Operation, opencdc.OperationUpdate)
+		is.Equal(record.Key, opencdc.StructuredData{"id": wantID})
+	}
+
+	is.NoErr(cdc.loadRecords(ctx))
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationUpdate)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "4"})
+
+	record, err = cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationDelete)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "3"})
+
+	// the delete moves only the archived cursor.
+	position, err := ParsePosition(record.Position)
+	is.NoErr(err)
+	is.True(position.Timestamp.Equal(time.Date(2022, 10, 28, 14, 0, 0, 0, time.UTC)))
+	is.True(position.ArchivedTimestamp.Equal(archivedAt))
+	is.Equal(position.ArchivedItemID, "3")
+}
+
+func TestCDC_Next_conversationMessages(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the first page holds the message 1, which was created before the position,
+	// so the first poll lists the next page, and the second poll lists it again.
+	gomock.InOrder(
+		client.EXPECT().
+			GetConversationMessages(gomock.Any(), "42", &hubspot.ListOptions{Limit: 10}).
+			Return(testListResponse(t, `{"results": [`+
+				`{"id": "1", "createdAt": "2022-10-28T11:00:00Z", "updatedAt": "2022-10-28T11:00:00Z"}], `+
+				`"paging": {"next": {"after": "1"}}}`), nil),
+		client.EXPECT().
+			GetConversationMessages(gomock.Any(), "42", &hubspot.ListOptions{Limit: 10, After: "1"}).
+			Return(testListResponse(t, `{"results": [`+
+				`{"id": "2", "createdAt": "2022-10-28T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z"}]}`), nil).
+			Times(2),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient:        client,
+		Resource:             "conversations.messages",
+		ConversationThreadID: "42",
+		BufferSize:           10,
+		PollingPeriod:        time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "2"})
+
+	position, err := ParsePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(position.After, "1")
+	is.True(position.Timestamp.Equal(time.Date(2022, 10, 28, 13, 0, 0, 0, time.UTC)))
+
+	// the second poll doesn't emit the message again.
+	is.NoErr(cdc.loadRecords(ctx))
+
+	hasNext, err := cdc.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+}
+
+func TestCDC_Next_recordKeyField(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	cdc, err := NewCDC(ctx, CDCParams{
+		HubSpotClient:  client,
+		Resource:       "crm.contacts",
+		RecordKeyField: "email",
+		BufferSize:     10,
+		PollingPeriod:  time.Hour,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(cdc.Stop)
+
+	record, err := cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationCreate)
+	is.Equal(record.Key, opencdc.StructuredData{"email": "bob@example.com"})
+
+	record, err = cdc.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationUpdate)
+	is.Equal(record.Key, opencdc.StructuredData{"email": "alice@example.com"})
+}
+
+// testLogBuffer is a bytes.Buffer guarded by a mutex,
+// so that the logs written by the iterator's goroutine can be read by a test.
+type testLogBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *testLogBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *testLogBuffer) Contains(s string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return bytes.Contains(b.buf.Bytes(), []byte(s))
+}
+
+func TestCDC_Next_truncationWarning(t *testing.T) {
+	t.Parallel()
+
+	const warning = "the results may be truncated"
+
+	tests := []struct {
+		name             string
+		bufferSize       int
+		truncationWarner bool
+		want             bool
+	}{
+		{
+			name:             "results_equal_buffer_size",
+			bufferSize:       2,
+			truncationWarner: true,
+			want:             true,
+		},
+		{
+			name:             "results_less_than_buffer_size",
+			bufferSize:       10,
+			truncationWarner: true,
+			want:             false,
+		},
+		{
+			name:             "warner_disabled",
+			bufferSize:       2,
+			truncationWarner: false,
+			want:             false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			client.EXPECT().
+				SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), tt.bufferSize, gomock.Any()).
+				Return(testListResponse(t, testCDCSearchResponse), nil)
+			// the archived items are not listed if the search fills the buffer.
+			client.EXPECT().
+				List(gomock.Any(), "crm.contacts", gomock.Any()).
+				Return(testListResponse(t, testCDCEmptyListResponse), nil).
+				MaxTimes(1)
+
+			var logs testLogBuffer
+			logger := zerolog.New(&logs)
+
+			ctx, cancel := context.WithCancel(logger.WithContext(context.Background()))
+			t.Cleanup(cancel)
+
+			timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+			cdc, err := NewCDC(ctx, CDCParams{
+				HubSpotClient: client,
+				Resource:      "crm.contacts",
+				BufferSize:    tt.bufferSize,
+				PollingPeriod: time.Hour,
+				Position: &Position{
+					Mode:      CDCPositionMode,
+					Timestamp: &timestamp,
+				},
+				CDCTruncationWarner: tt.truncationWarner,
+			})
+			is.NoErr(err)
+			t.Cleanup(cdc.Stop)
+
+			// the warning is logged before the records are sent.
+			for range 2 {
+				_, err = cdc.Next(ctx)
+				is.NoErr(err)
+			}
+
+			is.Equal(logs.Contains(warning), tt.want)
+		})
+	}
+}
+
+func TestCDC_Next_dedupWindow(t *testing.T) {
+	t.Parallel()
+
+	// the contact 1 is returned twice with the same update date.
+	const searchResponse = `{"total": 3, "results": [` +
+		`{"id": "1", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", ` +
+		`"properties": {"email": "bob@example.com"}}, ` +
+		`{"id": "1", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z", ` +
+		`"properties": {"email": "bob@example.com"}}, ` +
+		`{"id": "2", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T14:00:00Z", ` +
+		`"properties": {"email": "alice@example.com"}}]}`
+
+	tests := []struct {
+		name        string
+		dedupWindow time.Duration
+		want        []string
+	}{
+		{
+			name:        "enabled",
+			dedupWindow: time.Hour,
+			want:        []string{"1", "2"},
+		},
+		{
+			name:        "disabled",
+			dedupWindow: 0,
+			want:        []string{"1", "1", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			expectCDCSearch(t, client, searchResponse, testCDCEmptyListResponse)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+			cdc, err := NewCDC(ctx, CDCParams{
+				HubSpotClient: client,
+				Resource:      "crm.contacts",
+				BufferSize:    10,
+				PollingPeriod: time.Hour,
+				Position: &Position{
+					Mode:      CDCPositionMode,
+					Timestamp: &timestamp,
+				},
+				DedupWindow: tt.dedupWindow,
+			})
+			is.NoErr(err)
+			t.Cleanup(cdc.Stop)
+
+			ids := make([]string, 0, len(tt.want))
+			for range tt.want {
+				record, err := cdc.Next(ctx)
+				is.NoErr(err)
+
+				ids = append(ids, record.Key.(opencdc.StructuredData)["id"].(string))
+			}
+
+			is.Equal(ids, tt.want)
+		})
+	}
+}
+
+func TestCDC_isDuplicate(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	now := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2022, 10, 28, 11, 0, 0, 0, time.UTC)
+	item := hubspot.ListResponseResult{"id": "1"}
+
+	cdc := &CDC{
+		dedupWindow: time.Minute,
+		dedupSeen:   make(map[string]dedupEntry),
+	}
+
+	is.True(!cdc.isDuplicate(item, updatedAt, now))
+	is.True(cdc.isDuplicate(item, updatedAt, now.Add(time.Second)))
+
+	// another update of the same item is not a duplicate.
+	is.True(!cdc.isDuplicate(item, updatedAt.Add(time.Second), now.Add(time.Second)))
+
+	// the update is evicted once the window has passed, so it's emitted again.
+	cdc.evictDedupEntries(now.Add(time.Minute * 2))
+	is.Equal(len(cdc.dedupSeen), 0)
+	is.True(!cdc.isDuplicate(item, updatedAt.Add(time.Second), now.Add(time.Minute*2)))
+}
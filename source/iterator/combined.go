@@ -17,6 +17,7 @@ package iterator
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
@@ -29,44 +30,206 @@ type Combined struct {
 	snapshot *Snapshot
 	cdc      *CDC
 
-	hubspotClient   *hubspot.Client
-	resource        string
-	bufferSize      int
-	pollingPeriod   time.Duration
+	hubspotClient hubspot.ClientInterface
+	resource      string
+	// resourceAlias replaces the resource in the collection metadata if it's not empty.
+	resourceAlias string
+	// recordKeyField is the item's field which value becomes the record key.
+	recordKeyField string
+	// conversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	conversationThreadID string
+	bufferSize           int
+	pollingPeriod        time.Duration
+	// pollingJitter is an upper bound of a random delay added to each polling period.
+	pollingJitter   time.Duration
 	extraProperties []string
+	// cdcExtraProperties overrides the extraProperties in the cdc iterator if it's not empty.
+	cdcExtraProperties []string
+	propertyAllowlist  []string
+	propertyDenylist   []string
+	// excludeSystemProperties determines whether the HubSpot system properties are removed from items.
+	excludeSystemProperties bool
+	snapshotOrderBy         string
+	// snapshotSortBy defines whether the snapshot items are sorted by their creation or update date.
+	snapshotSortBy string
+	// snapshotBlogPostState filters the blog posts of the snapshot by their publication state.
+	snapshotBlogPostState hubspot.BlogPostState
+	// snapshotSitePageState filters the site pages of the snapshot by their publication state.
+	snapshotSitePageState hubspot.SitePageState
+	// snapshotFilter is a query the URL redirects of the snapshot are searched by.
+	snapshotFilter    string
+	skipEmptySnapshot bool
+	bulkSnapshot      bool
+	// snapshotCheckpointInterval is the number of records after which the snapshot position is updated.
+	snapshotCheckpointInterval int
+	semaphore                  chan struct{}
+	// binaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	binaryPosition bool
+	// rawPayload determines whether record payloads are JSON-encoded raw data instead of structured data.
+	rawPayload bool
+	// includeTranscript determines whether the transcripts of calls are added to their properties.
+	includeTranscript bool
+	// maxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	maxBufferWait time.Duration
+	// warmUpDelay is the time to wait after the snapshot before the cdc iterator is initialized.
+	warmUpDelay time.Duration
+	// dedupWindow is the duration within which the same update of an item is emitted only once.
+	dedupWindow time.Duration
+	// metrics holds the source counters that are updated by the underlying iterators.
+	metrics *Metrics
+	// ctx is the context the NewCombined is called with, it outlives the calls of the iterator's methods,
+	// so the snapshot iterator started by the SwitchToSnapshot is run with a context derived from it.
+	ctx context.Context
+	// cancel cancels the context of the snapshot iterator started by the SwitchToSnapshot.
+	cancel context.CancelFunc
+	// startPosition is a copy of the position the iterator started from.
+	startPosition *Position
+	// mu guards the lastPosition, which can be read while records are being read.
+	mu sync.Mutex
+	// lastPosition is the position of the last record returned by the Next method.
+	lastPosition opencdc.Position
 }
 
 // CombinedParams is an incoming params for the NewCombined function.
 type CombinedParams struct {
-	HubSpotClient   *hubspot.Client
-	Resource        string
-	BufferSize      int
-	PollingPeriod   time.Duration
+	HubSpotClient hubspot.ClientInterface
+	Resource      string
+	// ResourceAlias replaces the Resource in the collection metadata if it's not empty.
+	ResourceAlias string
+	// RecordKeyField is the item's field which value becomes the record key.
+	// If it's empty, the item's id is used.
+	RecordKeyField string
+	// ConversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	ConversationThreadID string
+	BufferSize           int
+	PollingPeriod        time.Duration
+	// PollingJitter is an upper bound of a random delay added to each polling period.
+	PollingJitter   time.Duration
 	Position        *Position
 	ExtraProperties []string
-	Snapshot        bool
+	// CDCExtraProperties overrides the ExtraProperties in the cdc iterator if it's not empty.
+	CDCExtraProperties []string
+	PropertyAllowlist  []string
+	PropertyDenylist   []string
+	// ExcludeSystemProperties determines whether the HubSpot system properties, prefixed with hs_, are removed from items.
+	ExcludeSystemProperties bool
+	Snapshot                bool
+	SnapshotOrderBy         string
+	// SnapshotSortBy is either the SortByCreatedAt or the SortByUpdatedAt, see the [SnapshotParams].
+	SnapshotSortBy string
+	// SnapshotBlogPostState filters the cms.blogs.posts items of the snapshot by their publication state.
+	SnapshotBlogPostState hubspot.BlogPostState
+	// SnapshotSitePageState filters the cms.pages.site items of the snapshot by their publication state.
+	SnapshotSitePageState hubspot.SitePageState
+	// SnapshotFilter is a query the cms.urlRedirects items of the snapshot are searched by.
+	SnapshotFilter    string
+	SkipEmptySnapshot bool
+	// BulkSnapshot determines whether the snapshot iterator will use the HubSpot export API.
+	BulkSnapshot bool
+	// SnapshotCheckpointInterval is the number of records after which the snapshot position is updated.
+	SnapshotCheckpointInterval int
+	// SnapshotPageCursor is an optional cursor the first page of the snapshot is listed from if there's no position.
+	// It's ignored when the snapshot is started again after switching to it.
+	SnapshotPageCursor string
+	// BinaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	BinaryPosition bool
+	// RawPayload determines whether record payloads are JSON-encoded [opencdc.RawData]
+	// instead of [opencdc.StructuredData].
+	RawPayload bool
+	// IncludeTranscript determines whether the transcripts of calls are added to their properties
+	// as the transcript property. It's ignored by resources other than crm.calls.
+	IncludeTranscript bool
+	// MaxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	// If it's zero, the iterators wait until the record is read.
+	MaxBufferWait time.Duration
+	// WarmUpDelay is the time to wait after the snapshot before the cdc iterator is initialized,
+	// so that the items written during the snapshot get into the HubSpot search index.
+	WarmUpDelay time.Duration
+	// DedupWindow is the duration within which the cdc iterator emits the same update of an item only once.
+	// If it's zero, the deduplication is disabled.
+	DedupWindow time.Duration
+	// WarnDefaultSnapshot determines whether the snapshot iterator will suggest disabling
+	// the snapshot that is enabled by default, if there are at least SnapshotWarningThreshold items.
+	WarnDefaultSnapshot      bool
+	SnapshotWarningThreshold int
+	// Semaphore is an optional semaphore that limits the number of unacknowledged records.
+	// A slot is acquired before each record is queued, and it's up to the caller to release it.
+	Semaphore chan struct{}
+	// Metrics is an optional set of counters that are updated by the underlying iterators.
+	Metrics *Metrics
 }
 
 // NewCombined creates new instance of the Combined.
 func NewCombined(ctx context.Context, params CombinedParams) (*Combined, error) {
 	combined := &Combined{
-		hubspotClient:   params.HubSpotClient,
-		resource:        params.Resource,
-		bufferSize:      params.BufferSize,
-		pollingPeriod:   params.PollingPeriod,
-		extraProperties: params.ExtraProperties,
+		hubspotClient:              params.HubSpotClient,
+		resource:                   params.Resource,
+		resourceAlias:              params.ResourceAlias,
+		recordKeyField:             params.RecordKeyField,
+		conversationThreadID:       params.ConversationThreadID,
+		bufferSize:                 params.BufferSize,
+		pollingPeriod:              params.PollingPeriod,
+		pollingJitter:              params.PollingJitter,
+		extraProperties:            params.ExtraProperties,
+		cdcExtraProperties:         params.CDCExtraProperties,
+		propertyAllowlist:          params.PropertyAllowlist,
+		propertyDenylist:           params.PropertyDenylist,
+		excludeSystemProperties:    params.ExcludeSystemProperties,
+		snapshotOrderBy:            params.SnapshotOrderBy,
+		snapshotSortBy:             params.SnapshotSortBy,
+		snapshotBlogPostState:      params.SnapshotBlogPostState,
+		snapshotSitePageState:      params.SnapshotSitePageState,
+		snapshotFilter:             params.SnapshotFilter,
+		skipEmptySnapshot:          params.SkipEmptySnapshot,
+		bulkSnapshot:               params.BulkSnapshot,
+		snapshotCheckpointInterval: params.SnapshotCheckpointInterval,
+		semaphore:                  params.Semaphore,
+		binaryPosition:             params.BinaryPosition,
+		rawPayload:                 params.RawPayload,
+		includeTranscript:          params.IncludeTranscript,
+		maxBufferWait:              params.MaxBufferWait,
+		warmUpDelay:                params.WarmUpDelay,
+		dedupWindow:                params.DedupWindow,
+		metrics:                    params.Metrics,
+		ctx:                        ctx,
+		// the underlying iterators modify the position, so it's copied before they're initialized.
+		startPosition: params.Position.clone(),
 	}
 
 	var err error
 	switch position := params.Position; {
 	case params.Snapshot && (position == nil || position.Mode == SnapshotPositionMode):
 		combined.snapshot, err = NewSnapshot(ctx, SnapshotParams{
-			HubSpotClient:   params.HubSpotClient,
-			Resource:        params.Resource,
-			BufferSize:      params.BufferSize,
-			PollingPeriod:   params.PollingPeriod,
-			Position:        params.Position,
-			ExtraProperties: params.ExtraProperties,
+			HubSpotClient:           params.HubSpotClient,
+			Resource:                params.Resource,
+			ResourceAlias:           params.ResourceAlias,
+			RecordKeyField:          params.RecordKeyField,
+			ConversationThreadID:    params.ConversationThreadID,
+			BufferSize:              params.BufferSize,
+			PollingPeriod:           params.PollingPeriod,
+			PollingJitter:           params.PollingJitter,
+			Position:                params.Position,
+			ExtraProperties:         params.ExtraProperties,
+			PropertyAllowlist:       params.PropertyAllowlist,
+			PropertyDenylist:        params.PropertyDenylist,
+			ExcludeSystemProperties: params.ExcludeSystemProperties,
+			OrderBy:                 params.SnapshotOrderBy,
+			SortBy:                  params.SnapshotSortBy,
+			BlogPostState:           params.SnapshotBlogPostState,
+			SitePageState:           params.SnapshotSitePageState,
+			Filter:                  params.SnapshotFilter,
+			SkipEmpty:               params.SkipEmptySnapshot,
+			BulkExport:              params.BulkSnapshot,
+			Semaphore:               params.Semaphore,
+			CheckpointInterval:      params.SnapshotCheckpointInterval,
+			PageCursor:              params.SnapshotPageCursor,
+			BinaryPosition:          params.BinaryPosition,
+			RawPayload:              params.RawPayload,
+			IncludeTranscript:       params.IncludeTranscript,
+			MaxBufferWait:           params.MaxBufferWait,
+			WarnDefault:             params.WarnDefaultSnapshot,
+			WarnThreshold:           params.SnapshotWarningThreshold,
+			Metrics:                 params.Metrics,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("init snapshot iterator: %w", err)
@@ -74,12 +237,29 @@ func NewCombined(ctx context.Context, params CombinedParams) (*Combined, error)
 
 	case !params.Snapshot || (position != nil && position.Mode == CDCPositionMode):
 		combined.cdc, err = NewCDC(ctx, CDCParams{
-			HubSpotClient:   params.HubSpotClient,
-			Resource:        params.Resource,
-			BufferSize:      params.BufferSize,
-			PollingPeriod:   params.PollingPeriod,
-			Position:        params.Position,
-			ExtraProperties: params.ExtraProperties,
+			HubSpotClient:           params.HubSpotClient,
+			Resource:                params.Resource,
+			ResourceAlias:           params.ResourceAlias,
+			RecordKeyField:          params.RecordKeyField,
+			ConversationThreadID:    params.ConversationThreadID,
+			BufferSize:              params.BufferSize,
+			PollingPeriod:           params.PollingPeriod,
+			PollingJitter:           params.PollingJitter,
+			Position:                params.Position,
+			ExtraProperties:         params.ExtraProperties,
+			CDCExtraProperties:      params.CDCExtraProperties,
+			PropertyAllowlist:       params.PropertyAllowlist,
+			PropertyDenylist:        params.PropertyDenylist,
+			ExcludeSystemProperties: params.ExcludeSystemProperties,
+			Semaphore:               params.Semaphore,
+			BinaryPosition:          params.BinaryPosition,
+			RawPayload:              params.RawPayload,
+			IncludeTranscript:       params.IncludeTranscript,
+			MaxBufferWait:           params.MaxBufferWait,
+			DedupWindow:             params.DedupWindow,
+			Metrics:                 params.Metrics,
+			// the truncation warning is enabled by default.
+			CDCTruncationWarner: true,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("init cdc iterator: %w", err)
@@ -124,31 +304,120 @@ func (c *Combined) HasNext(ctx context.Context) (bool, error) {
 
 // Next returns the next record.
 func (c *Combined) Next(ctx context.Context) (opencdc.Record, error) {
+	var (
+		record opencdc.Record
+		err    error
+	)
+
 	switch {
 	case c.snapshot != nil:
-		return c.snapshot.Next(ctx)
+		record, err = c.snapshot.Next(ctx)
 
 	case c.cdc != nil:
-		return c.cdc.Next(ctx)
+		record, err = c.cdc.Next(ctx)
 
 	default:
 		return opencdc.Record{}, ErrNoInitializedIterator
 	}
+
+	if err != nil {
+		return opencdc.Record{}, err
+	}
+
+	c.mu.Lock()
+	c.lastPosition = record.Position
+	c.mu.Unlock()
+
+	return record, nil
+}
+
+// Position returns a copy of the position of the last record returned by the [Combined.Next].
+// The underlying iterators load records ahead of the ones that are returned,
+// so their own positions aren't used, and the returned one is safe to checkpoint.
+// If no records have been returned yet, the position the iterator started from is returned,
+// which is nil if it started from scratch.
+func (c *Combined) Position() (*Position, error) {
+	c.mu.Lock()
+	lastPosition := c.lastPosition
+	c.mu.Unlock()
+
+	if lastPosition == nil {
+		return c.startPosition.clone(), nil
+	}
+
+	position, err := ParsePosition(lastPosition)
+	if err != nil {
+		return nil, fmt.Errorf("parse last position: %w", err)
+	}
+
+	return position, nil
+}
+
+// SDKPosition returns the [Combined.Position] marshaled in the position format of the iterator.
+// It's nil if the iterator started from scratch and no records have been returned yet.
+func (c *Combined) SDKPosition() (opencdc.Position, error) {
+	position, err := c.Position()
+	if err != nil {
+		return nil, err
+	}
+
+	if position == nil {
+		return nil, nil
+	}
+
+	sdkPosition, err := position.marshalSDKPosition(c.binaryPosition)
+	if err != nil {
+		return nil, fmt.Errorf("marshal position: %w", err)
+	}
+
+	return sdkPosition, nil
 }
 
 // switchToCDCIterator initializes the cdc iterator, and set the snapshot to nil.
+// If the warmUpDelay is set, the method waits for it before initializing the cdc iterator.
 func (c *Combined) switchToCDCIterator(ctx context.Context) error {
+	if c.warmUpDelay > 0 {
+		sdk.Logger(ctx).Info().Dur("warmUpDelay", c.warmUpDelay).Msg("waiting before switching to the CDC mode")
+
+		timer := time.NewTimer(c.warmUpDelay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled: %w", ctx.Err())
+
+		case <-timer.C:
+		}
+	}
+
 	var err error
 	c.cdc, err = NewCDC(ctx, CDCParams{
-		HubSpotClient: c.hubspotClient,
-		Resource:      c.resource,
-		BufferSize:    c.bufferSize,
-		PollingPeriod: c.pollingPeriod,
+		HubSpotClient:        c.hubspotClient,
+		Resource:             c.resource,
+		ResourceAlias:        c.resourceAlias,
+		RecordKeyField:       c.recordKeyField,
+		ConversationThreadID: c.conversationThreadID,
+		BufferSize:           c.bufferSize,
+		PollingPeriod:        c.pollingPeriod,
+		PollingJitter:        c.pollingJitter,
 		Position: &Position{
 			Mode:      CDCPositionMode,
 			Timestamp: &c.snapshot.initialTimestamp,
 		},
-		ExtraProperties: c.extraProperties,
+		ExtraProperties:         c.extraProperties,
+		CDCExtraProperties:      c.cdcExtraProperties,
+		PropertyAllowlist:       c.propertyAllowlist,
+		PropertyDenylist:        c.propertyDenylist,
+		ExcludeSystemProperties: c.excludeSystemProperties,
+		Semaphore:               c.semaphore,
+		BinaryPosition:          c.binaryPosition,
+		RawPayload:              c.rawPayload,
+		IncludeTranscript:       c.includeTranscript,
+		MaxBufferWait:           c.maxBufferWait,
+		DedupWindow:             c.dedupWindow,
+		Metrics:                 c.metrics,
+		// the truncation warning is enabled by default.
+		CDCTruncationWarner: true,
 	})
 	if err != nil {
 		return fmt.Errorf("init cdc iterator: %w", err)
@@ -160,13 +429,93 @@ func (c *Combined) switchToCDCIterator(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the underlying iterators.
+// SwitchToSnapshot stops the running iterator and initializes a new snapshot iterator
+// starting from a fresh snapshot position, so that the resource is snapshotted again.
+// The provided context may be cancelled once the method returns, so the snapshot iterator
+// is run with a context that is owned by the iterator and cancelled by the [Combined.Stop].
+func (c *Combined) SwitchToSnapshot(_ context.Context) error {
+	c.Stop()
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.cancel = cancel
+
+	c.snapshot = nil
+	c.cdc = nil
+
+	// the new snapshot starts from scratch, so do the positions.
+	c.mu.Lock()
+	c.startPosition = nil
+	c.lastPosition = nil
+	c.mu.Unlock()
+
+	var err error
+	c.snapshot, err = NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:           c.hubspotClient,
+		Resource:                c.resource,
+		ResourceAlias:           c.resourceAlias,
+		RecordKeyField:          c.recordKeyField,
+		ConversationThreadID:    c.conversationThreadID,
+		BufferSize:              c.bufferSize,
+		PollingPeriod:           c.pollingPeriod,
+		PollingJitter:           c.pollingJitter,
+		ExtraProperties:         c.extraProperties,
+		PropertyAllowlist:       c.propertyAllowlist,
+		PropertyDenylist:        c.propertyDenylist,
+		ExcludeSystemProperties: c.excludeSystemProperties,
+		OrderBy:                 c.snapshotOrderBy,
+		SortBy:                  c.snapshotSortBy,
+		BlogPostState:           c.snapshotBlogPostState,
+		SitePageState:           c.snapshotSitePageState,
+		Filter:                  c.snapshotFilter,
+		SkipEmpty:               c.skipEmptySnapshot,
+		BulkExport:              c.bulkSnapshot,
+		Semaphore:               c.semaphore,
+		CheckpointInterval:      c.snapshotCheckpointInterval,
+		BinaryPosition:          c.binaryPosition,
+		RawPayload:              c.rawPayload,
+		IncludeTranscript:       c.includeTranscript,
+		MaxBufferWait:           c.maxBufferWait,
+		Metrics:                 c.metrics,
+	})
+	if err != nil {
+		cancel()
+
+		return fmt.Errorf("init snapshot iterator: %w", err)
+	}
+
+	return nil
+}
+
+// Len returns the number of records that are loaded by the current underlying iterator
+// and wait to be returned.
+func (c *Combined) Len() int {
+	switch {
+	case c.snapshot != nil:
+		return c.snapshot.Len()
+
+	case c.cdc != nil:
+		return c.cdc.Len()
+
+	default:
+		return 0
+	}
+}
+
+// Stop stops the underlying iterators and drops the records they've loaded,
+// releasing the slots of the semaphore the records hold.
 func (c *Combined) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+
 	if c.snapshot != nil {
 		c.snapshot.Stop()
+		drain(c.snapshot.records, c.semaphore)
 	}
 
 	if c.cdc != nil {
 		c.cdc.Stop()
+		drain(c.cdc.records, c.semaphore)
 	}
 }
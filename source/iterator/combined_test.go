@@ -0,0 +1,451 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCombined_SwitchToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the cdc iterator doesn't find any changes.
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil)
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil)
+
+	// the snapshot iterator loads all the items.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, testSnapshotSearchResponse), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      false,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	hasNext, err := combined.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+
+	err = combined.SwitchToSnapshot(ctx)
+	is.NoErr(err)
+
+	hasNext, err = combined.HasNext(ctx)
+	is.NoErr(err)
+	is.True(hasNext)
+
+	record, err := combined.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+}
+
+func TestCombined_SwitchToSnapshot_cancelledContext(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the cdc iterator doesn't find any changes.
+	client.EXPECT().
+		SearchByUpdatedAfter(gomock.Any(), "crm.contacts", gomock.Any(), 10, gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil).
+		AnyTimes()
+	client.EXPECT().
+		List(gomock.Any(), "crm.contacts", gomock.Any()).
+		Return(testListResponse(t, testCDCEmptyListResponse), nil).
+		AnyTimes()
+
+	// the snapshot iterator estimates the total, as the first page doesn't hold all the items.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 1, "", gomock.Any(), "").
+		Return(testListResponse(t, `{"total": 2, "results": []}`), nil).
+		AnyTimes()
+
+	// the snapshot iterator loads the second page after the context of the reset is cancelled.
+	gomock.InOrder(
+		client.EXPECT().
+			SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+			Return(testListResponse(t, `{"total": 2, "results": [`+
+				`{"id": "1", "createdAt": "2022-10-28T14:58:27Z"}], "paging": {"next": {"after": "1"}}}`), nil),
+		client.EXPECT().
+			SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "1", gomock.Any(), "").
+			Return(testListResponse(t, `{"total": 2, "results": [`+
+				`{"id": "2", "createdAt": "2022-10-28T14:59:27Z"}]}`), nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Millisecond,
+		Snapshot:      false,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	resetCtx, resetCancel := context.WithCancel(ctx)
+
+	err = combined.SwitchToSnapshot(resetCtx)
+	is.NoErr(err)
+
+	resetCancel()
+
+	for _, wantID := range []string{"1", "2"} {
+		record, err := combined.Next(ctx)
+		is.NoErr(err)
+
+		is.Equal(record.Operation, opencdc.OperationSnapshot)
+		is.Equal(record.Key, opencdc.StructuredData{"id": wantID})
+	}
+}
+
+func TestCombined_SwitchToSnapshot_releaseSemaphore(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// both the initial and the new snapshot load the same item.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, testSnapshotSearchResponse), nil).
+		Times(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	semaphore := make(chan struct{}, 10)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      true,
+		Semaphore:     semaphore,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	is.Equal(len(semaphore), 1)
+
+	// the record of the stopped snapshot is never returned, so its slot is released.
+	err = combined.SwitchToSnapshot(ctx)
+	is.NoErr(err)
+
+	is.Equal(len(semaphore), 1)
+	is.Equal(combined.Len(), 1)
+
+	combined.Stop()
+
+	is.Equal(len(semaphore), 0)
+}
+
+func TestCombined_Next_dedupWindow(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the cdc iterator finds the same update of the contact 1 twice.
+	expectCDCSearch(t, client, `{"total": 2, "results": [`+
+		`{"id": "1", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z"}, `+
+		`{"id": "1", "createdAt": "2022-10-27T13:00:00Z", "updatedAt": "2022-10-28T13:00:00Z"}]}`,
+		testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      false,
+		DedupWindow:   time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	is.Equal(combined.Len(), 1)
+}
+
+func TestCombined_HasNext_warmUpDelay(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	const warmUpDelay = time.Millisecond * 100
+
+	client := newMockClient(t)
+
+	// the snapshot is empty, so the iterator switches to the cdc one right away.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, testCDCEmptyListResponse), nil)
+	expectCDCSearch(t, client, testCDCEmptyListResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      true,
+		WarmUpDelay:   warmUpDelay,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	before := time.Now()
+
+	hasNext, err := combined.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+
+	after := time.Now()
+
+	is.True(combined.cdc != nil)
+	is.True(after.Sub(before) >= warmUpDelay)
+}
+
+func TestCombined_Position_snapshot(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the snapshot iterator loads both contacts at once.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, `{"total": 2, "results": [`+
+			`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"hs_object_id": "1"}}, `+
+			`{"id": "2", "createdAt": "2022-10-28T14:59:27Z", "properties": {"hs_object_id": "2"}}]}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      true,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	position, err := combined.Position()
+	is.NoErr(err)
+	is.Equal(position, nil)
+
+	record, err := combined.Next(ctx)
+	is.NoErr(err)
+
+	position, err = combined.Position()
+	is.NoErr(err)
+	is.Equal(position.ItemID, "1")
+
+	recordPosition, err := ParsePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(position, recordPosition)
+}
+
+func TestCombined_Len(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the snapshot iterator loads all three contacts into the buffer at once.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, `{"total": 3, "results": [`+
+			`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"hs_object_id": "1"}}, `+
+			`{"id": "2", "createdAt": "2022-10-28T14:59:27Z", "properties": {"hs_object_id": "2"}}, `+
+			`{"id": "3", "createdAt": "2022-10-28T15:00:27Z", "properties": {"hs_object_id": "3"}}]}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      true,
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	is.Equal(combined.Len(), 3)
+
+	_, err = combined.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(combined.Len(), 2)
+}
+
+func TestCombined_Position_cdc(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectCDCSearch(t, client, testCDCSearchResponse, testCDCEmptyListResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// the cdc iterator modifies the timestamp of its position, so the expected one has its own.
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+	startTimestamp := timestamp
+	startPosition := &Position{
+		Mode:      CDCPositionMode,
+		Timestamp: &startTimestamp,
+	}
+
+	combined, err := NewCombined(ctx, CombinedParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Snapshot:      false,
+		Position: &Position{
+			Mode:      CDCPositionMode,
+			Timestamp: &timestamp,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(combined.Stop)
+
+	position, err := combined.Position()
+	is.NoErr(err)
+	is.Equal(position, startPosition)
+
+	// the returned position is a copy, so modifying it doesn't affect the iterator.
+	*position.Timestamp = position.Timestamp.Add(time.Hour)
+
+	position, err = combined.Position()
+	is.NoErr(err)
+	is.Equal(position, startPosition)
+
+	record, err := combined.Next(ctx)
+	is.NoErr(err)
+
+	position, err = combined.Position()
+	is.NoErr(err)
+	is.Equal(position.ItemID, "1")
+
+	recordPosition, err := ParsePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(position, recordPosition)
+}
+
+func TestCombined_SDKPosition(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+	position := &Position{
+		Mode:      CDCPositionMode,
+		ItemID:    "1",
+		Timestamp: &timestamp,
+	}
+
+	tests := []struct {
+		name           string
+		binaryPosition bool
+		marshal        func() (opencdc.Position, error)
+	}{
+		{
+			name:           "json",
+			binaryPosition: false,
+			marshal:        position.MarshalSDKPosition,
+		},
+		{
+			name:           "binary",
+			binaryPosition: true,
+			marshal:        position.BinaryMarshalSDKPosition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			combined := &Combined{
+				startPosition:  position,
+				binaryPosition: tt.binaryPosition,
+			}
+
+			got, err := combined.SDKPosition()
+			is.NoErr(err)
+
+			want, err := tt.marshal()
+			is.NoErr(err)
+			is.Equal(got, want)
+		})
+	}
+}
+
+func TestCombined_SDKPosition_noPosition(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	combined := &Combined{}
+
+	got, err := combined.SDKPosition()
+	is.NoErr(err)
+	is.Equal(got, nil)
+}
@@ -14,7 +14,10 @@
 
 package iterator
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrEmptyPosition occurs when a provided SDK's position is empty.
@@ -25,4 +28,18 @@ var (
 	ErrItemIDIsNotAString = errors.New("item's id is not a string")
 	// ErrNoInitializedIterator occurs when the Combined iterator has no any initialized underlying iterators.
 	ErrNoInitializedIterator = errors.New("no initialized iterator")
+	// ErrExportCanceled occurs when a HubSpot export used by the bulk snapshot is canceled.
+	ErrExportCanceled = errors.New("export is canceled")
+	// ErrBufferTimeout occurs when the records buffer stays full longer than the maximum buffer wait.
+	ErrBufferTimeout = errors.New("timed out waiting for the records buffer")
 )
+
+// InvalidPositionError occurs when a position is not consistent with its mode.
+type InvalidPositionError struct {
+	Reason string
+}
+
+// Error returns a formated error message for the [InvalidPositionError].
+func (e *InvalidPositionError) Error() string {
+	return fmt.Sprintf("invalid position: %s", e.Reason)
+}
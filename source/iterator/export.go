@@ -0,0 +1,142 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+)
+
+// export retrieves all the resource's items at once using the HubSpot export API.
+// It starts an export, waits until it's complete, checking its status every polling period,
+// and sends records for each row of the exported file. The export cannot be resumed,
+// so the iterator starts a new one each time.
+func (s *Snapshot) export(ctx context.Context) {
+	defer s.exporting.Store(false)
+
+	downloadURL, err := s.waitExport(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			// the iterator has been stopped.
+			return
+		}
+
+		s.errC <- fmt.Errorf("export %q items: %w", s.resource, err)
+
+		return
+	}
+
+	if err := s.loadExportedRecords(ctx, downloadURL); err != nil && ctx.Err() == nil {
+		s.errC <- fmt.Errorf("load exported records: %w", err)
+	}
+}
+
+// waitExport starts an export and waits until it's complete, returning its download URL.
+func (s *Snapshot) waitExport(ctx context.Context) (string, error) {
+	exportID, err := s.hubspotClient.BulkExport(ctx, s.resource, s.exportProperties())
+	if err != nil {
+		return "", fmt.Errorf("start export: %w", err)
+	}
+
+	timer := time.NewTimer(pollingInterval(s.pollingPeriod, s.pollingJitter))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("context cancelled: %w", ctx.Err())
+
+		case <-timer.C:
+			status, err := s.hubspotClient.PollExportStatus(ctx, exportID)
+			if err != nil {
+				return "", fmt.Errorf("poll export status: %w", err)
+			}
+
+			switch status.Status {
+			case hubspot.ExportStatusComplete:
+				return status.Result, nil
+
+			case hubspot.ExportStatusCanceled:
+				return "", ErrExportCanceled
+			}
+
+			timer.Reset(pollingInterval(s.pollingPeriod, s.pollingJitter))
+		}
+	}
+}
+
+// loadExportedRecords downloads an exported file and sends a record for each of its rows.
+// The file's header holds property names, the values of the object id property are used as item ids.
+func (s *Snapshot) loadExportedRecords(ctx context.Context, downloadURL string) error {
+	body, err := s.hubspotClient.DownloadExport(ctx, downloadURL)
+	if err != nil {
+		return fmt.Errorf("download export: %w", err)
+	}
+	defer body.Close()
+
+	reader := csv.NewReader(body)
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+
+	idFieldName := hubspot.SearchResources[s.resource].ObjectIDFilterName
+
+	for {
+		row, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("read row: %w", err)
+		}
+
+		properties := make(map[string]any, len(header))
+		for i, name := range header {
+			properties[name] = row[i]
+		}
+
+		item := hubspot.ListResponseResult{
+			hubspot.ResultsFieldID: properties[idFieldName],
+			propertiesFieldName:    properties,
+		}
+
+		// exported items don't contain their creation dates in the RFC 3339 format,
+		// so the initial timestamp is used instead.
+		if err := s.sendRecord(ctx, item, s.initialTimestamp, "", false); err != nil {
+			return err
+		}
+	}
+}
+
+// exportProperties returns the properties to export, which are the extra properties and the object id.
+func (s *Snapshot) exportProperties() []string {
+	idFieldName := hubspot.SearchResources[s.resource].ObjectIDFilterName
+
+	if slices.Contains(s.extraProperties, idFieldName) {
+		return s.extraProperties
+	}
+
+	return append([]string{idFieldName}, s.extraProperties...)
+}
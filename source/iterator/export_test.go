@@ -0,0 +1,106 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSnapshot_Next_bulkExport(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 1, "", nil, "").
+		Return(testListResponse(t, `{"total": 2, "results": []}`), nil)
+	client.EXPECT().
+		BulkExport(gomock.Any(), "crm.contacts", []string{"hs_object_id", "email"}).
+		Return("42", nil)
+
+	// the export is complete on the second status check.
+	gomock.InOrder(
+		client.EXPECT().
+			PollExportStatus(gomock.Any(), "42").
+			Return(&hubspot.ExportStatus{Status: hubspot.ExportStatusProcessing}, nil),
+		client.EXPECT().
+			PollExportStatus(gomock.Any(), "42").
+			Return(&hubspot.ExportStatus{
+				Status: hubspot.ExportStatusComplete,
+				Result: "https://files.example.com/export.csv",
+			}, nil),
+	)
+
+	client.EXPECT().
+		DownloadExport(gomock.Any(), "https://files.example.com/export.csv").
+		Return(io.NopCloser(strings.NewReader("hs_object_id,email\n1,bob@example.com\n2,alice@example.com\n")), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:   client,
+		Resource:        "crm.contacts",
+		BufferSize:      10,
+		PollingPeriod:   time.Millisecond,
+		ExtraProperties: []string{"email"},
+		BulkExport:      true,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	hasNext, err := snapshot.HasNext(ctx)
+	is.NoErr(err)
+	is.True(hasNext)
+
+	for _, want := range []struct {
+		id    string
+		email string
+	}{{"1", "bob@example.com"}, {"2", "alice@example.com"}} {
+		record, err := snapshot.Next(ctx)
+		is.NoErr(err)
+
+		is.Equal(record.Operation, opencdc.OperationSnapshot)
+		is.Equal(record.Key, opencdc.StructuredData{"id": want.id})
+
+		payload, ok := record.Payload.After.(opencdc.StructuredData)
+		is.True(ok)
+
+		is.Equal(payload["properties"], map[string]any{"hs_object_id": want.id, "email": want.email})
+	}
+}
+
+func TestSnapshot_exportProperties(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	snapshot := &Snapshot{resource: "crm.contacts", extraProperties: []string{"email"}}
+	is.Equal(snapshot.exportProperties(), []string{"hs_object_id", "email"})
+
+	snapshot.extraProperties = []string{"email", "hs_object_id"}
+	is.Equal(snapshot.exportProperties(), []string{"email", "hs_object_id"})
+}
@@ -0,0 +1,43 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// newMockClient creates a new mock of the HubSpot client that is checked when the test completes.
+func newMockClient(t testing.TB) *mock.MockClientInterface {
+	t.Helper()
+
+	return mock.NewMockClientInterface(gomock.NewController(t))
+}
+
+// testListResponse decodes a JSON body into a [hubspot.ListResponse].
+func testListResponse(t testing.TB, body string) *hubspot.ListResponse {
+	t.Helper()
+
+	var listResponse hubspot.ListResponse
+	if err := json.Unmarshal([]byte(body), &listResponse); err != nil {
+		t.Fatalf("unmarshal list response: %v", err)
+	}
+
+	return &listResponse
+}
@@ -0,0 +1,45 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// newRecordKey constructs a record key that holds the value of the item's keyField.
+// The field is looked up among the item's fields first, and then among its properties.
+// If the keyField is empty or it's the id field, the key holds the provided item id.
+// The function returns a *[hubspot.FieldNotExistError] if the item doesn't have the keyField.
+func newRecordKey(item hubspot.ListResponseResult, keyField, itemID string) (opencdc.StructuredData, error) {
+	if keyField == "" || keyField == hubspot.ResultsFieldID {
+		return opencdc.StructuredData{hubspot.ResultsFieldID: itemID}, nil
+	}
+
+	if value, ok := item[keyField]; ok {
+		return opencdc.StructuredData{keyField: value}, nil
+	}
+
+	if properties, ok := item[propertiesFieldName].(map[string]any); ok {
+		if value, ok := properties[keyField]; ok {
+			return opencdc.StructuredData{keyField: value}, nil
+		}
+	}
+
+	return nil, &hubspot.FieldNotExistError{
+		FieldName: keyField,
+		ObjectID:  itemID,
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+func TestNewRecordKey(t *testing.T) {
+	t.Parallel()
+
+	item := hubspot.ListResponseResult{
+		"id":        "1",
+		"createdAt": "2022-10-28T13:00:00Z",
+		"properties": map[string]any{
+			"email": "bob@example.com",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		keyField string
+		want     opencdc.StructuredData
+		wantErr  bool
+	}{
+		{
+			name:     "empty_key_field",
+			keyField: "",
+			want:     opencdc.StructuredData{"id": "1"},
+		},
+		{
+			name:     "id_key_field",
+			keyField: "id",
+			want:     opencdc.StructuredData{"id": "1"},
+		},
+		{
+			name:     "item_field",
+			keyField: "createdAt",
+			want:     opencdc.StructuredData{"createdAt": "2022-10-28T13:00:00Z"},
+		},
+		{
+			name:     "property",
+			keyField: "email",
+			want:     opencdc.StructuredData{"email": "bob@example.com"},
+		},
+		{
+			name:     "fail_missing_field",
+			keyField: "phone",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newRecordKey(item, tt.keyField, "1")
+			if tt.wantErr {
+				var fieldNotExistErr *hubspot.FieldNotExistError
+				if !errors.As(err, &fieldNotExistErr) {
+					t.Errorf("expected error to be FieldNotExistError, but got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("expected error to be nil, but got %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newRecordKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"strings"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+const (
+	// MetadataKeyResource is a metadata key for the name of a HubSpot resource a record comes from.
+	MetadataKeyResource = "hubspot.resource"
+	// MetadataKeyObjectType is a metadata key for the type of a HubSpot object a record represents.
+	MetadataKeyObjectType = "hubspot.objectType"
+)
+
+// newMetadata constructs a [opencdc.Metadata] for an item of the provided resource.
+// The collection is set to the alias if it's not empty, otherwise it's set to the resource.
+func newMetadata(resource, alias string, createdAt time.Time) opencdc.Metadata {
+	collection := resource
+	if alias != "" {
+		collection = alias
+	}
+
+	metadata := make(opencdc.Metadata)
+	metadata.SetCreatedAt(createdAt)
+	metadata.SetCollection(collection)
+	metadata[MetadataKeyResource] = resource
+	metadata[MetadataKeyObjectType] = objectType(resource)
+
+	return metadata
+}
+
+// objectType derives an object type from a resource name by trimming its API prefix,
+// e.g. "contacts" from "crm.contacts" or "blogs.authors" from "cms.blogs.authors".
+func objectType(resource string) string {
+	if _, objectType, ok := strings.Cut(resource, "."); ok {
+		return objectType
+	}
+
+	return resource
+}
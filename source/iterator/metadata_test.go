@@ -0,0 +1,83 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestNewMetadata(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	createdAt := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	metadata := newMetadata("crm.contacts", "", createdAt)
+
+	gotCreatedAt, err := metadata.GetCreatedAt()
+	is.NoErr(err)
+	is.True(gotCreatedAt.Equal(createdAt))
+
+	collection, err := metadata.GetCollection()
+	is.NoErr(err)
+	is.Equal(collection, "crm.contacts")
+
+	is.Equal(metadata[MetadataKeyResource], "crm.contacts")
+	is.Equal(metadata[MetadataKeyObjectType], "contacts")
+}
+
+func TestNewMetadata_alias(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	metadata := newMetadata("crm.contacts", "contacts", time.Now())
+
+	collection, err := metadata.GetCollection()
+	is.NoErr(err)
+	is.Equal(collection, "contacts")
+
+	// the resource is kept as is.
+	is.Equal(metadata[MetadataKeyResource], "crm.contacts")
+}
+
+func TestObjectType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		resource string
+		want     string
+	}{
+		{resource: "crm.contacts", want: "contacts"},
+		{resource: "cms.blogs.authors", want: "blogs.authors"},
+		{resource: "contacts", want: "contacts"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.resource, func(t *testing.T) {
+			t.Parallel()
+
+			if got := objectType(tt.resource); got != tt.want {
+				t.Errorf("objectType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
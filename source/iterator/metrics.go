@@ -0,0 +1,105 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Metrics holds the counters of the source that are updated while records are read.
+// Its methods are safe for concurrent use, and they do nothing if the Metrics is nil,
+// so the iterators can be used without it.
+type Metrics struct {
+	recordsRead       atomic.Uint64
+	errors            atomic.Uint64
+	lastPollDuration  atomic.Int64
+	snapshotRemaining atomic.Int64
+}
+
+// IncRecordsRead increments the number of records read.
+func (m *Metrics) IncRecordsRead() {
+	if m == nil {
+		return
+	}
+
+	m.recordsRead.Add(1)
+}
+
+// IncErrors increments the number of errors that occurred while reading records.
+func (m *Metrics) IncErrors() {
+	if m == nil {
+		return
+	}
+
+	m.errors.Add(1)
+}
+
+// RecordsRead returns the number of records read.
+func (m *Metrics) RecordsRead() uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return m.recordsRead.Load()
+}
+
+// Errors returns the number of errors that occurred while reading records.
+func (m *Metrics) Errors() uint64 {
+	if m == nil {
+		return 0
+	}
+
+	return m.errors.Load()
+}
+
+// LastPollDuration returns the duration of the last poll of the HubSpot API,
+// including the time it took to queue the loaded records.
+func (m *Metrics) LastPollDuration() time.Duration {
+	if m == nil {
+		return 0
+	}
+
+	return time.Duration(m.lastPollDuration.Load())
+}
+
+// SnapshotRemaining returns the approximate number of items that are left to snapshot.
+// It's based on the totals returned by the HubSpot API, so it's zero for resources that don't return them.
+func (m *Metrics) SnapshotRemaining() int64 {
+	if m == nil {
+		return 0
+	}
+
+	return m.snapshotRemaining.Load()
+}
+
+// observePoll sets the last poll duration to the time elapsed since the provided start.
+func (m *Metrics) observePoll(start time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.lastPollDuration.Store(int64(time.Since(start)))
+}
+
+// addSnapshotRemaining adds a provided delta to the number of items that are left to snapshot.
+// The snapshots of several resources can share the Metrics, so each of them reports only its changes.
+func (m *Metrics) addSnapshotRemaining(delta int64) {
+	if m == nil {
+		return
+	}
+
+	m.snapshotRemaining.Add(delta)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestMetrics_concurrent(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	const (
+		workers    = 8
+		iterations = 1000
+	)
+
+	var (
+		metrics Metrics
+		wg      sync.WaitGroup
+	)
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range iterations {
+				metrics.IncRecordsRead()
+				metrics.IncErrors()
+				metrics.addSnapshotRemaining(1)
+				metrics.addSnapshotRemaining(-1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	is.Equal(metrics.RecordsRead(), uint64(workers*iterations))
+	is.Equal(metrics.Errors(), uint64(workers*iterations))
+	is.Equal(metrics.SnapshotRemaining(), int64(0))
+}
+
+func TestMetrics_nil(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	var metrics *Metrics
+
+	metrics.IncRecordsRead()
+	metrics.IncErrors()
+	metrics.observePoll(time.Now())
+	metrics.addSnapshotRemaining(10)
+
+	is.Equal(metrics.RecordsRead(), uint64(0))
+	is.Equal(metrics.Errors(), uint64(0))
+	is.Equal(metrics.LastPollDuration(), time.Duration(0))
+	is.Equal(metrics.SnapshotRemaining(), int64(0))
+}
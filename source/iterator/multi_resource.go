@@ -0,0 +1,285 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// resourceIterator is an iterator of a single resource combined by the [MultiResourceCombined].
+type resourceIterator interface {
+	HasNext(ctx context.Context) (bool, error)
+	Next(ctx context.Context) (opencdc.Record, error)
+	SwitchToSnapshot(ctx context.Context) error
+	Len() int
+	Stop()
+}
+
+// MultiResourceCombined combines the [Combined] iterators of several resources
+// and returns their records in turns, so that none of the resources is starved.
+// Its positions are JSON objects that hold the position of each resource keyed by the resource name.
+type MultiResourceCombined struct {
+	resources []string
+	iterators []resourceIterator
+	// current is the index of the iterator which record is returned next.
+	current int
+	// semaphore is the optional semaphore of the source, a slot of which is acquired for each returned record.
+	semaphore chan struct{}
+	// semaphores hold a semaphore of each resource, at the same index as the resource,
+	// so that a resource which loaded as many records as the semaphore's capacity doesn't block the others.
+	// A slot of the resource's semaphore is released once its record is returned.
+	semaphores []chan struct{}
+	// mu guards the positions, which can be read while records are being read.
+	mu sync.Mutex
+	// positions holds the position of the last record returned for each resource.
+	positions map[string]opencdc.Position
+}
+
+// MultiResourceCombinedParams is an incoming params for the NewMultiResourceCombined function.
+type MultiResourceCombinedParams struct {
+	// Resources holds the names of the resources which records are read.
+	Resources []string
+	// Position is the position returned by the [MultiResourceCombined], it's empty if the iterator starts from scratch.
+	Position opencdc.Position
+	// Params are used to create the [Combined] iterator of each resource,
+	// their Resource and Position fields are set per resource.
+	Params CombinedParams
+}
+
+// NewMultiResourceCombined creates new instance of the MultiResourceCombined.
+func NewMultiResourceCombined(ctx context.Context, params MultiResourceCombinedParams) (*MultiResourceCombined, error) {
+	positions, err := ParseMultiResourcePosition(params.Position)
+	if err != nil && !errors.Is(err, ErrEmptyPosition) {
+		return nil, fmt.Errorf("parse multi-resource position: %w", err)
+	}
+
+	var semaphores []chan struct{}
+	if params.Params.Semaphore != nil {
+		semaphores = make([]chan struct{}, len(params.Resources))
+	}
+
+	iterators := make([]resourceIterator, 0, len(params.Resources))
+	for i, resource := range params.Resources {
+		position, err := ParsePosition(positions[resource])
+		if err != nil && !errors.Is(err, ErrEmptyPosition) {
+			stopIterators(iterators)
+
+			return nil, fmt.Errorf("parse %q position: %w", resource, err)
+		}
+
+		combinedParams := params.Params
+		combinedParams.Resource = resource
+		combinedParams.Position = position
+		combinedParams.WarnDefaultSnapshot = params.Params.WarnDefaultSnapshot && position == nil
+
+		// the initial load of each resource acquires slots, and nothing is acknowledged until all are created.
+		if semaphores != nil {
+			semaphores[i] = make(chan struct{}, cap(params.Params.Semaphore))
+			combinedParams.Semaphore = semaphores[i]
+		}
+
+		combined, err := NewCombined(ctx, combinedParams)
+		if err != nil {
+			stopIterators(iterators)
+
+			return nil, fmt.Errorf("init %q combined iterator: %w", resource, err)
+		}
+
+		iterators = append(iterators, combined)
+	}
+
+	multi := newMultiResourceCombined(params.Resources, iterators, positions)
+	multi.semaphore = params.Params.Semaphore
+	multi.semaphores = semaphores
+
+	return multi, nil
+}
+
+// newMultiResourceCombined creates new instance of the MultiResourceCombined with the provided iterators,
+// the iterator of each resource must be at the same index as the resource.
+// Positions of resources that aren't in the resources are dropped.
+func newMultiResourceCombined(
+	resources []string,
+	iterators []resourceIterator,
+	positions map[string]opencdc.Position,
+) *MultiResourceCombined {
+	multi := &MultiResourceCombined{
+		resources: resources,
+		iterators: iterators,
+		positions: make(map[string]opencdc.Position, len(resources)),
+	}
+
+	for _, resource := range resources {
+		if position, ok := positions[resource]; ok {
+			multi.positions[resource] = position
+		}
+	}
+
+	return multi
+}
+
+// HasNext returns a bool indicating whether any of the resources has a record to return.
+// Resources are checked in turns starting with the one which record is returned next.
+func (m *MultiResourceCombined) HasNext(ctx context.Context) (bool, error) {
+	for i := range m.iterators {
+		index := (m.current + i) % len(m.iterators)
+
+		hasNext, err := m.iterators[index].HasNext(ctx)
+		if err != nil {
+			return false, fmt.Errorf("%q has next: %w", m.resources[index], err)
+		}
+
+		if hasNext {
+			m.current = index
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Next returns the next record of the resource found by the [MultiResourceCombined.HasNext].
+// The record's position holds the positions of all the resources.
+// If the semaphore is set, the method blocks until its slot is acquired for the record,
+// and releases the slot the record holds in the semaphore of its resource.
+func (m *MultiResourceCombined) Next(ctx context.Context) (opencdc.Record, error) {
+	if len(m.iterators) == 0 {
+		return opencdc.Record{}, ErrNoInitializedIterator
+	}
+
+	index := m.current
+
+	if err := acquire(ctx, m.semaphore, 0); err != nil {
+		return opencdc.Record{}, fmt.Errorf("acquire semaphore: %w", err)
+	}
+
+	record, err := m.iterators[index].Next(ctx)
+	if err != nil {
+		release(m.semaphore)
+
+		return opencdc.Record{}, fmt.Errorf("%q next: %w", m.resources[index], err)
+	}
+
+	if m.semaphores != nil {
+		release(m.semaphores[index])
+	}
+
+	// the next record is looked up starting with the next resource.
+	m.current = (index + 1) % len(m.iterators)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.positions[m.resources[index]] = record.Position
+
+	record.Position, err = marshalMultiResourcePosition(m.positions)
+	if err != nil {
+		return opencdc.Record{}, fmt.Errorf("marshal multi-resource position: %w", err)
+	}
+
+	return record, nil
+}
+
+// SDKPosition returns the position of the last records returned for each resource.
+// It's nil if the iterator started from scratch and no records have been returned yet.
+func (m *MultiResourceCombined) SDKPosition() (opencdc.Position, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.positions) == 0 {
+		return nil, nil
+	}
+
+	sdkPosition, err := marshalMultiResourcePosition(m.positions)
+	if err != nil {
+		return nil, fmt.Errorf("marshal multi-resource position: %w", err)
+	}
+
+	return sdkPosition, nil
+}
+
+// SwitchToSnapshot switches the iterators of all the resources to the snapshot mode.
+func (m *MultiResourceCombined) SwitchToSnapshot(ctx context.Context) error {
+	for i, iterator := range m.iterators {
+		if err := iterator.SwitchToSnapshot(ctx); err != nil {
+			return fmt.Errorf("switch %q to snapshot: %w", m.resources[i], err)
+		}
+	}
+
+	// the new snapshots start from scratch, so do the positions.
+	m.mu.Lock()
+	m.positions = make(map[string]opencdc.Position, len(m.resources))
+	m.mu.Unlock()
+
+	m.current = 0
+
+	return nil
+}
+
+// Len returns the total number of records that are loaded by the iterators of all the resources
+// and wait to be returned.
+func (m *MultiResourceCombined) Len() int {
+	var total int
+	for _, iterator := range m.iterators {
+		total += iterator.Len()
+	}
+
+	return total
+}
+
+// Stop stops the iterators of all the resources.
+func (m *MultiResourceCombined) Stop() {
+	stopIterators(m.iterators)
+}
+
+// ParseMultiResourcePosition converts an [opencdc.Position] returned by the [MultiResourceCombined]
+// into the positions of its resources keyed by the resource name.
+func ParseMultiResourcePosition(sdkPosition opencdc.Position) (map[string]opencdc.Position, error) {
+	if len(sdkPosition) == 0 {
+		return nil, ErrEmptyPosition
+	}
+
+	var positions map[string]opencdc.Position
+	if err := json.Unmarshal(sdkPosition, &positions); err != nil {
+		return nil, fmt.Errorf("unmarshal opencdc.Position into multi-resource position: %w", err)
+	}
+
+	return positions, nil
+}
+
+// marshalMultiResourcePosition marshals the positions of resources into an [opencdc.Position].
+// The positions are encoded as base64 strings by the JSON encoder, so that both position formats are kept as is.
+func marshalMultiResourcePosition(positions map[string]opencdc.Position) (opencdc.Position, error) {
+	sdkPosition, err := json.Marshal(positions)
+	if err != nil {
+		return nil, fmt.Errorf("marshal positions: %w", err)
+	}
+
+	return sdkPosition, nil
+}
+
+// stopIterators stops all the provided iterators.
+func stopIterators(iterators []resourceIterator) {
+	for _, iterator := range iterators {
+		iterator.Stop()
+	}
+}
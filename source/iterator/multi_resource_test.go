@@ -0,0 +1,389 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+	"go.uber.org/mock/gomock"
+)
+
+// testEngagementResources holds the resources of the crm.engagements resource group.
+var testEngagementResources = []string{"crm.calls", "crm.emails", "crm.meetings", "crm.notes", "crm.tasks"}
+
+// testResourceIterator is a resourceIterator that returns the provided records.
+type testResourceIterator struct {
+	records  []opencdc.Record
+	err      error
+	switched bool
+	stopped  bool
+}
+
+func (it *testResourceIterator) HasNext(context.Context) (bool, error) {
+	if it.err != nil {
+		return false, it.err
+	}
+
+	return len(it.records) > 0, nil
+}
+
+func (it *testResourceIterator) Next(context.Context) (opencdc.Record, error) {
+	record := it.records[0]
+	it.records = it.records[1:]
+
+	return record, nil
+}
+
+func (it *testResourceIterator) SwitchToSnapshot(context.Context) error {
+	it.switched = true
+
+	return nil
+}
+
+func (it *testResourceIterator) Len() int {
+	return len(it.records)
+}
+
+func (it *testResourceIterator) Stop() {
+	it.stopped = true
+}
+
+// newTestResourceIterator returns a testResourceIterator with the count records of the resource,
+// positions of the records are the resource name followed by the record's number.
+func newTestResourceIterator(resource string, count int) *testResourceIterator {
+	it := &testResourceIterator{}
+	for i := range count {
+		it.records = append(it.records, opencdc.Record{
+			Position: opencdc.Position(resource + "-" + strconv.Itoa(i+1)),
+			Metadata: opencdc.Metadata{opencdc.MetadataCollection: resource},
+		})
+	}
+
+	return it
+}
+
+func TestMultiResourceCombined_Next_roundRobin(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	// crm.meetings has no records, and crm.notes has more records than the others.
+	counts := map[string]int{"crm.calls": 1, "crm.emails": 1, "crm.meetings": 0, "crm.notes": 3, "crm.tasks": 1}
+
+	iterators := make([]resourceIterator, 0, len(testEngagementResources))
+	for _, resource := range testEngagementResources {
+		iterators = append(iterators, newTestResourceIterator(resource, counts[resource]))
+	}
+
+	multi := newMultiResourceCombined(testEngagementResources, iterators, nil)
+
+	var collections []string
+	for {
+		hasNext, err := multi.HasNext(ctx)
+		is.NoErr(err)
+
+		if !hasNext {
+			break
+		}
+
+		record, err := multi.Next(ctx)
+		is.NoErr(err)
+
+		collection, err := record.Metadata.GetCollection()
+		is.NoErr(err)
+
+		collections = append(collections, collection)
+	}
+
+	is.Equal(collections, []string{"crm.calls", "crm.emails", "crm.notes", "crm.tasks", "crm.notes", "crm.notes"})
+}
+
+func TestMultiResourceCombined_HasNext_resources(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	resources := []string{"crm.contacts", "crm.notes"}
+
+	multi := newMultiResourceCombined(resources, []resourceIterator{
+		newTestResourceIterator("crm.contacts", 2),
+		newTestResourceIterator("crm.notes", 1),
+	}, nil)
+
+	var collections []string
+	for range 3 {
+		hasNext, err := multi.HasNext(ctx)
+		is.NoErr(err)
+		is.True(hasNext)
+
+		record, err := multi.Next(ctx)
+		is.NoErr(err)
+
+		collection, err := record.Metadata.GetCollection()
+		is.NoErr(err)
+
+		collections = append(collections, collection)
+	}
+
+	hasNext, err := multi.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+
+	is.Equal(collections, []string{"crm.contacts", "crm.notes", "crm.contacts"})
+}
+
+func TestMultiResourceCombined_Next_position(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctx := context.Background()
+
+	iterators := make([]resourceIterator, 0, len(testEngagementResources))
+	for _, resource := range testEngagementResources {
+		iterators = append(iterators, newTestResourceIterator(resource, 1))
+	}
+
+	// the position of crm.tasks is kept until its record is returned,
+	// and the position of a resource that's not in the group is dropped.
+	multi := newMultiResourceCombined(testEngagementResources, iterators, map[string]opencdc.Position{
+		"crm.tasks":    opencdc.Position("crm.tasks-0"),
+		"crm.contacts": opencdc.Position("crm.contacts-1"),
+	})
+
+	sdkPosition, err := multi.SDKPosition()
+	is.NoErr(err)
+
+	positions, err := ParseMultiResourcePosition(sdkPosition)
+	is.NoErr(err)
+	is.Equal(positions, map[string]opencdc.Position{"crm.tasks": opencdc.Position("crm.tasks-0")})
+
+	hasNext, err := multi.HasNext(ctx)
+	is.NoErr(err)
+	is.True(hasNext)
+
+	record, err := multi.Next(ctx)
+	is.NoErr(err)
+
+	positions, err = ParseMultiResourcePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(positions, map[string]opencdc.Position{
+		"crm.calls": opencdc.Position("crm.calls-1"),
+		"crm.tasks": opencdc.Position("crm.tasks-0"),
+	})
+
+	hasNext, err = multi.HasNext(ctx)
+	is.NoErr(err)
+	is.True(hasNext)
+
+	record, err = multi.Next(ctx)
+	is.NoErr(err)
+
+	positions, err = ParseMultiResourcePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(positions, map[string]opencdc.Position{
+		"crm.calls":  opencdc.Position("crm.calls-1"),
+		"crm.emails": opencdc.Position("crm.emails-1"),
+		"crm.tasks":  opencdc.Position("crm.tasks-0"),
+	})
+
+	sdkPosition, err = multi.SDKPosition()
+	is.NoErr(err)
+	is.Equal(sdkPosition, record.Position)
+}
+
+func TestNewMultiResourceCombined_fullFirstPage(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	resources := []string{"crm.contacts", "crm.notes"}
+
+	client := newMockClient(t)
+
+	// the first page of each resource holds as many items as the buffer size.
+	for _, resource := range resources {
+		client.EXPECT().
+			SearchByCreatedBefore(gomock.Any(), resource, gomock.Any(), 2, "", gomock.Any(), "").
+			Return(testListResponse(t, `{"total": 2, "results": [`+
+				`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"hs_object_id": "1"}}, `+
+				`{"id": "2", "createdAt": "2022-10-28T14:58:28Z", "properties": {"hs_object_id": "2"}}]}`), nil)
+	}
+
+	// the semaphore is as large as the buffer, so a shared one would be full after the first resource is loaded.
+	semaphore := make(chan struct{}, 2)
+
+	// the timeout fails the test instead of blocking it if the iterator is deadlocked.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	multi, err := NewMultiResourceCombined(ctx, MultiResourceCombinedParams{
+		Resources: resources,
+		Params: CombinedParams{
+			HubSpotClient: client,
+			BufferSize:    2,
+			PollingPeriod: time.Hour,
+			Snapshot:      true,
+			Semaphore:     semaphore,
+		},
+	})
+	is.NoErr(err)
+	t.Cleanup(multi.Stop)
+
+	var collections []string
+	for range 4 {
+		hasNext, err := multi.HasNext(ctx)
+		is.NoErr(err)
+		is.True(hasNext)
+
+		record, err := multi.Next(ctx)
+		is.NoErr(err)
+		is.Equal(len(semaphore), 1)
+
+		collection, err := record.Metadata.GetCollection()
+		is.NoErr(err)
+
+		collections = append(collections, collection)
+
+		// the record is acknowledged.
+		<-semaphore
+	}
+
+	is.Equal(collections, []string{"crm.contacts", "crm.notes", "crm.contacts", "crm.notes"})
+}
+
+func TestMultiResourceCombined_HasNext_fail(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	errHasNext := errors.New("test error")
+
+	multi := newMultiResourceCombined([]string{"crm.calls", "crm.emails"}, []resourceIterator{
+		&testResourceIterator{},
+		&testResourceIterator{err: errHasNext},
+	}, nil)
+
+	_, err := multi.HasNext(context.Background())
+	is.True(errors.Is(err, errHasNext))
+}
+
+func TestMultiResourceCombined_SwitchToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	calls := newTestResourceIterator("crm.calls", 1)
+	emails := newTestResourceIterator("crm.emails", 1)
+
+	multi := newMultiResourceCombined([]string{"crm.calls", "crm.emails"}, []resourceIterator{calls, emails},
+		map[string]opencdc.Position{"crm.calls": opencdc.Position("crm.calls-0")})
+
+	err := multi.SwitchToSnapshot(context.Background())
+	is.NoErr(err)
+
+	is.True(calls.switched)
+	is.True(emails.switched)
+
+	sdkPosition, err := multi.SDKPosition()
+	is.NoErr(err)
+	is.Equal(sdkPosition, nil)
+
+	multi.Stop()
+
+	is.True(calls.stopped)
+	is.True(emails.stopped)
+}
+
+func TestMultiResourceCombined_Len(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	multi := newMultiResourceCombined([]string{"crm.calls", "crm.emails"}, []resourceIterator{
+		newTestResourceIterator("crm.calls", 2),
+		newTestResourceIterator("crm.emails", 3),
+	}, nil)
+
+	is.Equal(multi.Len(), 5)
+
+	_, err := multi.Next(context.Background())
+	is.NoErr(err)
+
+	is.Equal(multi.Len(), 4)
+}
+
+func TestParseMultiResourcePosition(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	positions := map[string]opencdc.Position{
+		"crm.calls":  opencdc.Position(`{"mode":"cdc"}`),
+		"crm.emails": opencdc.Position{0x08, 0x01},
+	}
+
+	sdkPosition, err := marshalMultiResourcePosition(positions)
+	is.NoErr(err)
+
+	got, err := ParseMultiResourcePosition(sdkPosition)
+	is.NoErr(err)
+	is.Equal(got, positions)
+}
+
+func TestParseMultiResourcePosition_fail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		sdkPosition opencdc.Position
+	}{
+		{
+			name:        "empty",
+			sdkPosition: nil,
+		},
+		{
+			name:        "single_resource_position",
+			sdkPosition: opencdc.Position(`{"mode":"cdc","timestamp":"2022-10-28T12:00:00Z"}`),
+		},
+		{
+			name:        "not_json",
+			sdkPosition: opencdc.Position("position"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			_, err := ParseMultiResourcePosition(tt.sdkPosition)
+			is.True(err != nil)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// newRecordPayload constructs a record payload from a provided item.
+// If the raw is true, the item is marshaled as JSON into [opencdc.RawData],
+// otherwise it's used as is as [opencdc.StructuredData].
+func newRecordPayload(item hubspot.ListResponseResult, raw bool) (opencdc.Data, error) {
+	if !raw {
+		return opencdc.StructuredData(item), nil
+	}
+
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("marshal item: %w", err)
+	}
+
+	return opencdc.RawData(payload), nil
+}
@@ -0,0 +1,30 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"math/rand"
+	"time"
+)
+
+// pollingInterval returns the provided period increased by a random jitter
+// in the range [0, jitter). If the jitter is not positive the period is returned as is.
+func pollingInterval(period, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return period
+	}
+
+	return period + time.Duration(rand.Int63n(int64(jitter))) //nolint:gosec // jitter doesn't need a secure source
+}
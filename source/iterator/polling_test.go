@@ -0,0 +1,112 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/matryer/is"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCDC_poll_pollingJitter(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	const (
+		pollingPeriod = 200 * time.Millisecond
+		pollingJitter = 100 * time.Millisecond
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// firstTick starts a new CDC iterator and returns the duration between its start and its first tick.
+	firstTick := func() time.Duration {
+		client := newMockClient(t)
+
+		var started atomic.Bool
+		tickC := make(chan time.Time, 1)
+
+		client.EXPECT().
+			List(gomock.Any(), "cms.domains", gomock.Any()).
+			DoAndReturn(func(context.Context, string, *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+				if started.Load() {
+					select {
+					case tickC <- time.Now():
+					default:
+					}
+				}
+
+				return testListResponse(t, testCDCEmptyListResponse), nil
+			}).
+			AnyTimes()
+
+		cdc, err := NewCDC(ctx, CDCParams{
+			HubSpotClient: client,
+			Resource:      "cms.domains",
+			BufferSize:    10,
+			PollingPeriod: pollingPeriod,
+			PollingJitter: pollingJitter,
+		})
+		is.NoErr(err)
+		t.Cleanup(cdc.Stop)
+
+		startedAt := time.Now()
+		started.Store(true)
+
+		select {
+		case tickedAt := <-tickC:
+			return tickedAt.Sub(startedAt)
+
+		case <-time.After(time.Second):
+			t.Fatal("expected the iterator to tick, but it didn't")
+
+			return 0
+		}
+	}
+
+	first, second := firstTick(), firstTick()
+
+	is.True(first >= pollingPeriod)
+	is.True(second >= pollingPeriod)
+
+	diff := first - second
+	if diff < 0 {
+		diff = -diff
+	}
+
+	is.True(diff <= pollingJitter)
+}
+
+func TestPollingInterval(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	is.Equal(pollingInterval(time.Second, 0), time.Second)
+
+	for i := 0; i < 100; i++ {
+		interval := pollingInterval(time.Second, time.Millisecond*100)
+
+		is.True(interval >= time.Second)
+		is.True(interval < time.Second+time.Millisecond*100)
+	}
+}
@@ -39,10 +39,18 @@ type Position struct {
 	Mode PositionMode `json:"mode"`
 	// ItemID is used if the position's mode is [SnapshotPositionMode].
 	ItemID string `json:"itemId,omitempty"`
+	// After is a search cursor of the page to continue a [SnapshotPositionMode] position of a search-based resource,
+	// or a [CDCPositionMode] position of a resource that is paged only by the cursor.
+	After string `json:"after,omitempty"`
 	// InitialTimestamp is an initial timestamp of a snapshot.
 	InitialTimestamp *time.Time `json:"initialTimestamp,omitempty"`
 	// Timestamp is used if the position's mode is [CDCPositionMode], or for [SnapshotPositionMode] if it was interrupted.
 	Timestamp *time.Time `json:"timestamp,omitempty"`
+	// ArchivedTimestamp is the archive date of the last archived item emitted by a [CDCPositionMode] position,
+	// it's tracked separately from the Timestamp, as archived items are listed apart from updated ones.
+	ArchivedTimestamp *time.Time `json:"archivedTimestamp,omitempty"`
+	// ArchivedItemID is the id of the last archived item emitted by a [CDCPositionMode] position.
+	ArchivedItemID string `json:"archivedItemId,omitempty"`
 }
 
 // MarshalSDKPosition marshals the underlying position into a [opencdc.Position] as JSON bytes.
@@ -55,17 +63,83 @@ func (p *Position) MarshalSDKPosition() (opencdc.Position, error) {
 	return opencdc.Position(positionBytes), nil
 }
 
-// ParsePosition converts an [opencdc.Position] into a [Position].
-func ParsePosition(sdkPosition opencdc.Position) (*Position, error) {
-	var position Position
+// Validate checks whether the position is consistent with its mode.
+// A [SnapshotPositionMode] position must have the InitialTimestamp,
+// and a [CDCPositionMode] position must have the Timestamp.
+// The method returns an [InvalidPositionError] if the position is not valid.
+func (p *Position) Validate() error {
+	switch p.Mode {
+	case SnapshotPositionMode:
+		if p.InitialTimestamp == nil {
+			return &InvalidPositionError{Reason: "snapshot position has no initial timestamp"}
+		}
+
+	case CDCPositionMode:
+		if p.Timestamp == nil {
+			return &InvalidPositionError{Reason: "cdc position has no timestamp"}
+		}
+
+	default:
+		return &InvalidPositionError{Reason: fmt.Sprintf("unknown mode %q", p.Mode)}
+	}
+
+	return nil
+}
+
+// clone returns a deep copy of the position. If the position is nil, the method returns nil.
+func (p *Position) clone() *Position {
+	if p == nil {
+		return nil
+	}
+
+	position := *p
+
+	if p.InitialTimestamp != nil {
+		initialTimestamp := *p.InitialTimestamp
+		position.InitialTimestamp = &initialTimestamp
+	}
 
+	if p.Timestamp != nil {
+		timestamp := *p.Timestamp
+		position.Timestamp = &timestamp
+	}
+
+	if p.ArchivedTimestamp != nil {
+		archivedTimestamp := *p.ArchivedTimestamp
+		position.ArchivedTimestamp = &archivedTimestamp
+	}
+
+	return &position
+}
+
+// ParsePosition converts an [opencdc.Position] into a [Position] and validates it.
+// Positions that aren't JSON objects are parsed with the [ParseBinaryPosition],
+// so that both position formats can be read regardless of the configured one.
+func ParsePosition(sdkPosition opencdc.Position) (*Position, error) {
 	if len(sdkPosition) == 0 {
 		return nil, ErrEmptyPosition
 	}
 
-	if err := json.Unmarshal(sdkPosition, &position); err != nil {
-		return nil, fmt.Errorf("unmarshal opencdc.Position into Position: %w", err)
+	var (
+		position *Position
+		err      error
+	)
+
+	if sdkPosition[0] != '{' {
+		position, err = ParseBinaryPosition(sdkPosition)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		position = new(Position)
+		if err := json.Unmarshal(sdkPosition, position); err != nil {
+			return nil, fmt.Errorf("unmarshal opencdc.Position into Position: %w", err)
+		}
+	}
+
+	if err := position.Validate(); err != nil {
+		return nil, fmt.Errorf("validate position: %w", err)
 	}
 
-	return &position, nil
+	return position, nil
 }
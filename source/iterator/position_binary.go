@@ -0,0 +1,199 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The field numbers of the Position message defined in the position.proto.
+const (
+	positionFieldMode              protowire.Number = 1
+	positionFieldItemID            protowire.Number = 2
+	positionFieldAfter             protowire.Number = 3
+	positionFieldInitialTimestamp  protowire.Number = 4
+	positionFieldTimestamp         protowire.Number = 5
+	positionFieldArchivedTimestamp protowire.Number = 6
+	positionFieldArchivedItemID    protowire.Number = 7
+)
+
+// The field numbers of the google.protobuf.Timestamp message.
+const (
+	timestampFieldSeconds protowire.Number = 1
+	timestampFieldNanos   protowire.Number = 2
+)
+
+// BinaryMarshalSDKPosition marshals the underlying position into a [opencdc.Position]
+// as the Protocol Buffers Position message defined in the position.proto.
+func (p *Position) BinaryMarshalSDKPosition() (opencdc.Position, error) {
+	var b []byte
+
+	b = appendStringField(b, positionFieldMode, string(p.Mode))
+	b = appendStringField(b, positionFieldItemID, p.ItemID)
+	b = appendStringField(b, positionFieldAfter, p.After)
+	b = appendTimestampField(b, positionFieldInitialTimestamp, p.InitialTimestamp)
+	b = appendTimestampField(b, positionFieldTimestamp, p.Timestamp)
+	b = appendTimestampField(b, positionFieldArchivedTimestamp, p.ArchivedTimestamp)
+	b = appendStringField(b, positionFieldArchivedItemID, p.ArchivedItemID)
+
+	return opencdc.Position(b), nil
+}
+
+// ParseBinaryPosition converts an [opencdc.Position] marshaled by the [Position.BinaryMarshalSDKPosition]
+// into a [Position]. Unknown fields are skipped.
+func ParseBinaryPosition(sdkPosition opencdc.Position) (*Position, error) {
+	var position Position
+
+	if len(sdkPosition) == 0 {
+		return nil, ErrEmptyPosition
+	}
+
+	b := []byte(sdkPosition)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, fmt.Errorf("consume field tag: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		var err error
+		switch {
+		case num == positionFieldMode && typ == protowire.BytesType:
+			var mode string
+			mode, n = protowire.ConsumeString(b)
+			position.Mode = PositionMode(mode)
+
+		case num == positionFieldItemID && typ == protowire.BytesType:
+			position.ItemID, n = protowire.ConsumeString(b)
+
+		case num == positionFieldAfter && typ == protowire.BytesType:
+			position.After, n = protowire.ConsumeString(b)
+
+		case num == positionFieldInitialTimestamp && typ == protowire.BytesType:
+			position.InitialTimestamp, n, err = consumeTimestamp(b)
+
+		case num == positionFieldTimestamp && typ == protowire.BytesType:
+			position.Timestamp, n, err = consumeTimestamp(b)
+
+		case num == positionFieldArchivedTimestamp && typ == protowire.BytesType:
+			position.ArchivedTimestamp, n, err = consumeTimestamp(b)
+
+		case num == positionFieldArchivedItemID && typ == protowire.BytesType:
+			position.ArchivedItemID, n = protowire.ConsumeString(b)
+
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("consume field %d: %w", num, err)
+		}
+
+		if n < 0 {
+			return nil, fmt.Errorf("consume field %d: %w", num, protowire.ParseError(n))
+		}
+		b = b[n:]
+	}
+
+	return &position, nil
+}
+
+// marshalSDKPosition marshals the position with the [Position.BinaryMarshalSDKPosition] if binary is true,
+// otherwise with the [Position.MarshalSDKPosition].
+func (p *Position) marshalSDKPosition(binary bool) (opencdc.Position, error) {
+	if binary {
+		return p.BinaryMarshalSDKPosition()
+	}
+
+	return p.MarshalSDKPosition()
+}
+
+// appendStringField appends a string field to b, unless the value is empty as proto3 does.
+func appendStringField(b []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return b
+	}
+
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendString(b, value)
+}
+
+// appendTimestampField appends a google.protobuf.Timestamp field to b, unless the value is nil.
+func appendTimestampField(b []byte, num protowire.Number, value *time.Time) []byte {
+	if value == nil {
+		return b
+	}
+
+	var timestamp []byte
+	if seconds := value.Unix(); seconds != 0 {
+		timestamp = protowire.AppendTag(timestamp, timestampFieldSeconds, protowire.VarintType)
+		timestamp = protowire.AppendVarint(timestamp, uint64(seconds))
+	}
+
+	if nanos := value.Nanosecond(); nanos != 0 {
+		timestamp = protowire.AppendTag(timestamp, timestampFieldNanos, protowire.VarintType)
+		timestamp = protowire.AppendVarint(timestamp, uint64(nanos))
+	}
+
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+
+	return protowire.AppendBytes(b, timestamp)
+}
+
+// consumeTimestamp parses a google.protobuf.Timestamp field value from b
+// and returns it in UTC with the number of consumed bytes.
+func consumeTimestamp(b []byte) (*time.Time, int, error) {
+	timestamp, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return nil, n, nil
+	}
+
+	var seconds, nanos int64
+	for len(timestamp) > 0 {
+		num, typ, m := protowire.ConsumeTag(timestamp)
+		if m < 0 {
+			return nil, 0, fmt.Errorf("consume timestamp field tag: %w", protowire.ParseError(m))
+		}
+		timestamp = timestamp[m:]
+
+		var value uint64
+		switch {
+		case num == timestampFieldSeconds && typ == protowire.VarintType:
+			value, m = protowire.ConsumeVarint(timestamp)
+			seconds = int64(value)
+
+		case num == timestampFieldNanos && typ == protowire.VarintType:
+			value, m = protowire.ConsumeVarint(timestamp)
+			nanos = int64(int32(value))
+
+		default:
+			m = protowire.ConsumeFieldValue(num, typ, timestamp)
+		}
+
+		if m < 0 {
+			return nil, 0, fmt.Errorf("consume timestamp field %d: %w", num, protowire.ParseError(m))
+		}
+		timestamp = timestamp[m:]
+	}
+
+	t := time.Unix(seconds, nanos).UTC()
+
+	return &t, n, nil
+}
@@ -0,0 +1,215 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+func TestPosition_BinaryMarshalSDKPosition(t *testing.T) {
+	t.Parallel()
+
+	p := &Position{
+		Mode:   CDCPositionMode,
+		ItemID: "1",
+	}
+
+	got, err := p.BinaryMarshalSDKPosition()
+	if err != nil {
+		t.Fatalf("expected error to be nil, but got %v", err)
+	}
+
+	// field 1 (mode) and field 2 (itemId) as length-delimited strings.
+	want := opencdc.Position([]byte{0x0a, 0x03, 'c', 'd', 'c', 0x12, 0x01, '1'})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Position.BinaryMarshalSDKPosition() = %v, want %v", got, want)
+	}
+}
+
+func TestParseBinaryPosition_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	initialTimestamp := time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC)
+	timestamp := time.Date(2022, 10, 28, 15, 0, 50, 123456789, time.UTC)
+	epoch := time.Unix(0, 0).UTC()
+	beforeEpoch := time.Date(1969, 12, 31, 23, 59, 59, 500, time.UTC)
+
+	tests := []struct {
+		name     string
+		position *Position
+	}{
+		{
+			name: "snapshot",
+			position: &Position{
+				Mode:             SnapshotPositionMode,
+				ItemID:           "256",
+				After:            "100",
+				InitialTimestamp: &initialTimestamp,
+			},
+		},
+		{
+			name: "cdc",
+			position: &Position{
+				Mode:             CDCPositionMode,
+				ItemID:           "256",
+				InitialTimestamp: &initialTimestamp,
+				Timestamp:        &timestamp,
+			},
+		},
+		{
+			name: "cdc_archived",
+			position: &Position{
+				Mode:              CDCPositionMode,
+				ItemID:            "256",
+				Timestamp:         &timestamp,
+				ArchivedTimestamp: &initialTimestamp,
+				ArchivedItemID:    "128",
+			},
+		},
+		{
+			name: "epoch_timestamp",
+			position: &Position{
+				Mode:      CDCPositionMode,
+				Timestamp: &epoch,
+			},
+		},
+		{
+			name: "timestamp_before_epoch",
+			position: &Position{
+				Mode:      CDCPositionMode,
+				Timestamp: &beforeEpoch,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdkPosition, err := tt.position.BinaryMarshalSDKPosition()
+			if err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			got, err := ParseBinaryPosition(sdkPosition)
+			if err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.position) {
+				t.Errorf("ParseBinaryPosition() = %+v, want %+v", got, tt.position)
+			}
+
+			// the ParsePosition detects the binary format.
+			got, err = ParsePosition(sdkPosition)
+			if err != nil {
+				t.Fatalf("expected error to be nil, but got %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.position) {
+				t.Errorf("ParsePosition() = %+v, want %+v", got, tt.position)
+			}
+		})
+	}
+}
+
+func TestParseBinaryPosition_fail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		sdkPosition opencdc.Position
+		wantErr     error
+	}{
+		{
+			name:        "empty",
+			sdkPosition: opencdc.Position{},
+			wantErr:     ErrEmptyPosition,
+		},
+		{
+			name:        "truncated_string",
+			sdkPosition: opencdc.Position([]byte{0x0a, 0x03, 'c'}),
+		},
+		{
+			name:        "truncated_timestamp",
+			sdkPosition: opencdc.Position([]byte{0x22, 0x02, 0x08}),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseBinaryPosition(tt.sdkPosition)
+			if err == nil {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error to be %v, but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPosition_marshalSDKPosition compares the JSON and binary position formats
+// by marshaling and parsing a CDC position, which happens for every record.
+func BenchmarkPosition_marshalSDKPosition(b *testing.B) {
+	initialTimestamp := time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC)
+	timestamp := time.Date(2022, 10, 28, 15, 0, 50, 123456789, time.UTC)
+
+	position := &Position{
+		Mode:             CDCPositionMode,
+		ItemID:           "12345678901",
+		InitialTimestamp: &initialTimestamp,
+		Timestamp:        &timestamp,
+	}
+
+	for _, binary := range []bool{false, true} {
+		name := "json"
+		if binary {
+			name = "proto"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			var size int
+			for range b.N {
+				sdkPosition, err := position.marshalSDKPosition(binary)
+				if err != nil {
+					b.Fatalf("expected error to be nil, but got %v", err)
+				}
+
+				if _, err := ParsePosition(sdkPosition); err != nil {
+					b.Fatalf("expected error to be nil, but got %v", err)
+				}
+
+				size = len(sdkPosition)
+			}
+
+			b.ReportMetric(float64(size), "bytes/position")
+		})
+	}
+}
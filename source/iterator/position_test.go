@@ -15,8 +15,10 @@
 package iterator
 
 import (
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/conduitio/conduit-commons/opencdc"
 )
@@ -74,6 +76,8 @@ func TestPosition_MarshalSDKPosition(t *testing.T) {
 func TestParsePosition(t *testing.T) {
 	t.Parallel()
 
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
 	type args struct {
 		sdkPosition opencdc.Position
 	}
@@ -87,14 +91,31 @@ func TestParsePosition(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				sdkPosition: opencdc.Position([]byte(`{"mode":"cdc","itemId": "1"}`)),
+				sdkPosition: opencdc.Position([]byte(`{"mode":"cdc","itemId": "1","timestamp":"2022-10-28T12:00:00Z"}`)),
 			},
 			want: &Position{
-				Mode:   CDCPositionMode,
-				ItemID: "1",
+				Mode:      CDCPositionMode,
+				ItemID:    "1",
+				Timestamp: &timestamp,
 			},
 			wantErr: false,
 		},
+		{
+			name: "fail_cdc_position_without_timestamp",
+			args: args{
+				sdkPosition: opencdc.Position([]byte(`{"mode":"cdc","itemId": "1"}`)),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_position_without_initial_timestamp",
+			args: args{
+				sdkPosition: opencdc.Position([]byte(`{"mode":"snapshot","timestamp":"2022-10-28T12:00:00Z"}`)),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "fail_empty_position",
 			args: args{
@@ -132,3 +153,98 @@ func TestParsePosition(t *testing.T) {
 		})
 	}
 }
+
+func TestPosition_Validate(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2022, 10, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		position Position
+		wantErr  bool
+	}{
+		{
+			name: "success_snapshot",
+			position: Position{
+				Mode:             SnapshotPositionMode,
+				InitialTimestamp: &timestamp,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_snapshot_with_timestamp",
+			position: Position{
+				Mode:             SnapshotPositionMode,
+				InitialTimestamp: &timestamp,
+				Timestamp:        &timestamp,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_cdc",
+			position: Position{
+				Mode:      CDCPositionMode,
+				Timestamp: &timestamp,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_snapshot_without_timestamps",
+			position: Position{
+				Mode: SnapshotPositionMode,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail_snapshot_with_timestamp_only",
+			position: Position{
+				Mode:      SnapshotPositionMode,
+				Timestamp: &timestamp,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail_cdc_without_timestamps",
+			position: Position{
+				Mode: CDCPositionMode,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail_cdc_with_initial_timestamp_only",
+			position: Position{
+				Mode:             CDCPositionMode,
+				InitialTimestamp: &timestamp,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail_unknown_mode",
+			position: Position{
+				Mode:             "unknown",
+				InitialTimestamp: &timestamp,
+				Timestamp:        &timestamp,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.position.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Position.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var invalidPositionErr *InvalidPositionError
+			if tt.wantErr && !errors.As(err, &invalidPositionErr) {
+				t.Errorf("Position.Validate() error = %v, want *InvalidPositionError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"strings"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+)
+
+const (
+	// propertiesFieldName is a name of the item's field that holds its properties.
+	propertiesFieldName = "properties"
+	// systemPropertyPrefix is a prefix of the names of HubSpot system properties, e.g. hs_object_id.
+	systemPropertyPrefix = "hs_"
+)
+
+// filterProperties keeps only properties listed in the allowlist (if it's not empty)
+// and removes properties listed in the denylist from the item's properties.
+// Note that the item's properties map is modified in place, the returned item is the same one that was passed.
+// The item is returned as is if both lists are empty or the item has no properties.
+func filterProperties(item hubspot.ListResponseResult, allowlist, denylist []string) hubspot.ListResponseResult {
+	if len(allowlist) == 0 && len(denylist) == 0 {
+		return item
+	}
+
+	properties, ok := item[propertiesFieldName].(map[string]any)
+	if !ok {
+		return item
+	}
+
+	if len(allowlist) > 0 {
+		allowed := make(map[string]struct{}, len(allowlist))
+		for _, name := range allowlist {
+			allowed[name] = struct{}{}
+		}
+
+		for name := range properties {
+			if _, ok := allowed[name]; !ok {
+				delete(properties, name)
+			}
+		}
+	}
+
+	for _, name := range denylist {
+		delete(properties, name)
+	}
+
+	return item
+}
+
+// removeSystemProperties removes the HubSpot system properties, which names start with the hs_ prefix,
+// from the item's properties. Like the [filterProperties], it modifies the item's properties map in place.
+func removeSystemProperties(item hubspot.ListResponseResult) hubspot.ListResponseResult {
+	properties, ok := item[propertiesFieldName].(map[string]any)
+	if !ok {
+		return item
+	}
+
+	for name := range properties {
+		if strings.HasPrefix(name, systemPropertyPrefix) {
+			delete(properties, name)
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,195 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+)
+
+func TestFilterProperties(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		item      hubspot.ListResponseResult
+		allowlist []string
+		denylist  []string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want hubspot.ListResponseResult
+	}{
+		{
+			name: "success_denylist",
+			args: args{
+				item: hubspot.ListResponseResult{
+					"id": "1",
+					"properties": map[string]any{
+						"email":        "bob@example.com",
+						"hs_object_id": "1",
+						"createdate":   "2022-10-28T14:58:27Z",
+					},
+				},
+				denylist: []string{"hs_object_id", "createdate"},
+			},
+			want: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email": "bob@example.com",
+				},
+			},
+		},
+		{
+			name: "success_empty_denylist",
+			args: args{
+				item: hubspot.ListResponseResult{
+					"id": "1",
+					"properties": map[string]any{
+						"email":        "bob@example.com",
+						"hs_object_id": "1",
+					},
+				},
+				denylist: nil,
+			},
+			want: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email":        "bob@example.com",
+					"hs_object_id": "1",
+				},
+			},
+		},
+		{
+			name: "success_allowlist",
+			args: args{
+				item: hubspot.ListResponseResult{
+					"id": "1",
+					"properties": map[string]any{
+						"email":        "bob@example.com",
+						"hs_object_id": "1",
+						"createdate":   "2022-10-28T14:58:27Z",
+					},
+				},
+				allowlist: []string{"email", "firstname"},
+			},
+			want: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email": "bob@example.com",
+				},
+			},
+		},
+		{
+			name: "success_no_properties",
+			args: args{
+				item: hubspot.ListResponseResult{
+					"id":   "1",
+					"name": "Bob",
+				},
+				denylist: []string{"name"},
+			},
+			want: hubspot.ListResponseResult{
+				"id":   "1",
+				"name": "Bob",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := filterProperties(tt.args.item, tt.args.allowlist, tt.args.denylist); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveSystemProperties(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		item hubspot.ListResponseResult
+		want hubspot.ListResponseResult
+	}{
+		{
+			name: "success",
+			item: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email":             "bob@example.com",
+					"hs_object_id":      "1",
+					"hs_pipeline_stage": "1",
+					"firstname":         "Bob",
+				},
+			},
+			want: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email":     "bob@example.com",
+					"firstname": "Bob",
+				},
+			},
+		},
+		{
+			name: "success_no_system_properties",
+			item: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email":     "bob@example.com",
+					"hsbc_code": "1",
+				},
+			},
+			want: hubspot.ListResponseResult{
+				"id": "1",
+				"properties": map[string]any{
+					"email":     "bob@example.com",
+					"hsbc_code": "1",
+				},
+			},
+		},
+		{
+			name: "success_no_properties",
+			item: hubspot.ListResponseResult{
+				"id":           "1",
+				"hs_object_id": "1",
+			},
+			want: hubspot.ListResponseResult{
+				"id":           "1",
+				"hs_object_id": "1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := removeSystemProperties(tt.item); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("removeSystemProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
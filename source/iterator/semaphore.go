@@ -0,0 +1,68 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// acquire blocks until a slot of the semaphore is acquired or the context is done.
+// If no slot is freed within the maxWait, the function returns the [ErrBufferTimeout],
+// so that records that are never acknowledged don't block polling indefinitely.
+// If the maxWait is zero, the function blocks until a slot is acquired or the context is done.
+// If the semaphore is nil, the function returns immediately.
+func acquire(ctx context.Context, semaphore chan struct{}, maxWait time.Duration) error {
+	if semaphore == nil {
+		return nil
+	}
+
+	// the semaphore is usually not full, so the timer is created only if a slot cannot be acquired right away.
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+
+	default:
+	}
+
+	var timeout <-chan time.Time
+	if maxWait > 0 {
+		timer := time.NewTimer(maxWait)
+		defer timer.Stop()
+
+		timeout = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context cancelled: %w", ctx.Err())
+
+	case <-timeout:
+		return ErrBufferTimeout
+
+	case semaphore <- struct{}{}:
+		return nil
+	}
+}
+
+// release releases a slot of the semaphore if any is acquired.
+// If the semaphore is nil, the function does nothing.
+func release(semaphore chan struct{}) {
+	select {
+	case <-semaphore:
+	default:
+	}
+}
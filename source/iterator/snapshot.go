@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
@@ -25,17 +26,76 @@ import (
 	sdk "github.com/conduitio/conduit-connector-sdk"
 )
 
+const (
+	// SortByCreatedAt sorts and filters the snapshot items by their creation date.
+	SortByCreatedAt = "createdAt"
+	// SortByUpdatedAt sorts and filters the snapshot items of search-based resources by their last update date.
+	SortByUpdatedAt = "updatedAt"
+)
+
 // Snapshot is an implementation of a Snapshot iterator for the HubSpot API.
 type Snapshot struct {
-	hubspotClient   *hubspot.Client
-	resource        string
-	bufferSize      int
-	pollingPeriod   time.Duration
-	records         chan opencdc.Record
-	errC            chan error
-	stopC           chan struct{}
+	hubspotClient hubspot.ClientInterface
+	resource      string
+	// resourceAlias replaces the resource in the collection metadata if it's not empty.
+	resourceAlias string
+	// recordKeyField is the item's field which value becomes the record key.
+	recordKeyField string
+	// conversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	conversationThreadID string
+	bufferSize           int
+	pollingPeriod        time.Duration
+	// pollingJitter is an upper bound of a random delay added to each polling period.
+	pollingJitter time.Duration
+	records       chan opencdc.Record
+	errC          chan error
+	// cancel cancels the context of the iterator's goroutines.
+	cancel          context.CancelFunc
 	position        *Position
 	extraProperties []string
+	// checkpointInterval is the number of records after which the position is updated.
+	checkpointInterval int
+	// uncheckpointed is the number of records sent since the position was updated last time.
+	uncheckpointed int
+	// sdkPosition is the last marshaled position, records between checkpoints carry it.
+	sdkPosition opencdc.Position
+	// binaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	binaryPosition bool
+	// rawPayload determines whether record payloads are JSON-encoded raw data instead of structured data.
+	rawPayload bool
+	// includeTranscript determines whether the transcripts of calls are added to their properties.
+	includeTranscript bool
+	// maxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	maxBufferWait time.Duration
+	// propertyAllowlist holds properties that will be kept in items.
+	propertyAllowlist []string
+	// propertyDenylist holds properties that will be removed from items.
+	propertyDenylist []string
+	// excludeSystemProperties determines whether the HubSpot system properties are removed from items.
+	excludeSystemProperties bool
+	// orderBy holds a field name that is used to sort items,
+	// if it's empty, the items are sorted by their creation date.
+	orderBy string
+	// sortBy is either the SortByCreatedAt or the SortByUpdatedAt.
+	sortBy string
+	// blogPostState filters blog posts by their publication state, if it's empty, posts of all states are listed.
+	blogPostState hubspot.BlogPostState
+	// sitePageState filters site pages by their publication state, if it's empty, pages of all states are listed.
+	sitePageState hubspot.SitePageState
+	// filter is a query the URL redirects are searched by, if it's empty, all redirects are listed.
+	filter string
+	// skipEmpty determines whether the iterator will stop polling
+	// if the initial load doesn't return any items.
+	skipEmpty bool
+	// bulkExport determines whether the iterator will retrieve all items at once
+	// using the HubSpot export API instead of paging through them.
+	bulkExport bool
+	// semaphore limits the number of unacknowledged records.
+	semaphore chan struct{}
+	// warnDefault determines whether the iterator will suggest disabling
+	// the snapshot that is enabled by default, if there are at least warnThreshold items.
+	warnDefault   bool
+	warnThreshold int
 	// initialTimestamp will be used to retrieve all items
 	// that are created before this date.
 	initialTimestamp time.Time
@@ -43,54 +103,252 @@ type Snapshot struct {
 	nextLink string
 	// hasMoreItems is used for both timestamp- and search-based resources.
 	hasMoreItems bool
+	// after is a cursor of the next page of search-based resources.
+	after string
+	// searchCompleted is true once the last page of a search-based resource is loaded.
+	searchCompleted bool
+	// exporting is true while the bulk export is in progress.
+	exporting atomic.Bool
+	// metrics holds the source counters, the iterator updates the last poll duration and the remaining items.
+	metrics *Metrics
+	// loaded is the number of items loaded by the iterator.
+	loaded int64
+	// reportedRemaining is the number of remaining items the iterator has added to the metrics.
+	reportedRemaining atomic.Int64
 }
 
 // SnapshotParams is an incoming params for the [NewSnapshot] function.
 type SnapshotParams struct {
-	HubSpotClient   *hubspot.Client
-	Resource        string
-	BufferSize      int
-	PollingPeriod   time.Duration
-	Position        *Position
-	ExtraProperties []string
+	HubSpotClient hubspot.ClientInterface
+	Resource      string
+	// ResourceAlias replaces the Resource in the collection metadata if it's not empty.
+	ResourceAlias string
+	// RecordKeyField is the item's field which value becomes the record key.
+	// If it's empty, the item's id is used.
+	RecordKeyField string
+	// ConversationThreadID is the id of the thread which messages are listed for the conversations.messages.
+	ConversationThreadID string
+	BufferSize           int
+	PollingPeriod        time.Duration
+	// PollingJitter is an upper bound of a random delay added to each polling period.
+	PollingJitter     time.Duration
+	Position          *Position
+	ExtraProperties   []string
+	PropertyAllowlist []string
+	PropertyDenylist  []string
+	// ExcludeSystemProperties determines whether the HubSpot system properties, prefixed with hs_, are removed from items.
+	ExcludeSystemProperties bool
+	OrderBy                 string
+	// SortBy defines whether search-based items are sorted and filtered by their creation date,
+	// which is the default, or by their last update date. It's either SortByCreatedAt or SortByUpdatedAt.
+	SortBy string
+	// BlogPostState filters the cms.blogs.posts items by their publication state if it's not empty.
+	BlogPostState hubspot.BlogPostState
+	// SitePageState filters the cms.pages.site items by their publication state if it's not empty.
+	SitePageState hubspot.SitePageState
+	// Filter is a query the cms.urlRedirects items are searched by if it's not empty.
+	Filter    string
+	SkipEmpty bool
+	// BulkExport determines whether the iterator will retrieve all items at once
+	// using the HubSpot export API instead of paging through them.
+	BulkExport bool
+	Semaphore  chan struct{}
+	// CheckpointInterval is the number of records after which the position is updated.
+	// The last record of each page always updates it. If it's less than one, every record updates it.
+	CheckpointInterval int
+	// PageCursor is an optional cursor the first page is listed from if there's no Position,
+	// so that an interrupted snapshot can be resumed from a known page.
+	PageCursor string
+	// BinaryPosition determines whether positions are marshaled in the Protocol Buffers format instead of JSON.
+	BinaryPosition bool
+	// RawPayload determines whether record payloads are JSON-encoded [opencdc.RawData]
+	// instead of [opencdc.StructuredData].
+	RawPayload bool
+	// IncludeTranscript determines whether the transcripts of calls are added to their properties
+	// as the transcript property. It's ignored by resources other than crm.calls.
+	IncludeTranscript bool
+	// MaxBufferWait is the maximum time to wait for a free slot of the records buffer.
+	// If it's zero, the iterator waits until the record is read.
+	MaxBufferWait time.Duration
+	// WarnDefault determines whether the iterator will suggest disabling the snapshot
+	// that is enabled by default, if there are at least WarnThreshold items.
+	WarnDefault   bool
+	WarnThreshold int
+	// Metrics is an optional set of counters, the iterator updates the last poll duration and the remaining items.
+	Metrics *Metrics
 }
 
 // NewSnapshot creates a new instance of the [Snapshot].
 func NewSnapshot(ctx context.Context, params SnapshotParams) (*Snapshot, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
 	snapshot := &Snapshot{
-		hubspotClient:    params.HubSpotClient,
-		resource:         params.Resource,
-		bufferSize:       params.BufferSize,
-		pollingPeriod:    params.PollingPeriod,
-		records:          make(chan opencdc.Record, params.BufferSize),
-		errC:             make(chan error, 1),
-		stopC:            make(chan struct{}, 1),
-		position:         params.Position,
-		extraProperties:  params.ExtraProperties,
-		initialTimestamp: time.Now().UTC(),
+		hubspotClient:           params.HubSpotClient,
+		resource:                params.Resource,
+		resourceAlias:           params.ResourceAlias,
+		recordKeyField:          params.RecordKeyField,
+		conversationThreadID:    params.ConversationThreadID,
+		bufferSize:              params.BufferSize,
+		pollingPeriod:           params.PollingPeriod,
+		pollingJitter:           params.PollingJitter,
+		records:                 make(chan opencdc.Record, params.BufferSize),
+		errC:                    make(chan error, 1),
+		cancel:                  cancel,
+		position:                params.Position,
+		extraProperties:         params.ExtraProperties,
+		propertyAllowlist:       params.PropertyAllowlist,
+		propertyDenylist:        params.PropertyDenylist,
+		excludeSystemProperties: params.ExcludeSystemProperties,
+		orderBy:                 params.OrderBy,
+		sortBy:                  params.SortBy,
+		blogPostState:           params.BlogPostState,
+		sitePageState:           params.SitePageState,
+		filter:                  params.Filter,
+		skipEmpty:               params.SkipEmpty,
+		bulkExport:              params.BulkExport,
+		semaphore:               params.Semaphore,
+		binaryPosition:          params.BinaryPosition,
+		rawPayload:              params.RawPayload,
+		maxBufferWait:           params.MaxBufferWait,
+		warnDefault:             params.WarnDefault,
+		warnThreshold:           params.WarnThreshold,
+		metrics:                 params.Metrics,
+		initialTimestamp:        time.Now().UTC(),
 	}
 
+	snapshot.checkpointInterval = max(params.CheckpointInterval, 1)
+
+	// the multi-resource iterator shares the params between resources, so the resource is checked here.
+	snapshot.includeTranscript = params.IncludeTranscript && params.Resource == hubspot.CallsResource
+
 	if snapshot.position != nil && snapshot.position.InitialTimestamp != nil {
 		snapshot.initialTimestamp = *snapshot.position.InitialTimestamp
+		snapshot.after = snapshot.position.After
 	} else {
 		snapshot.position = &Position{
 			Mode:             SnapshotPositionMode,
 			InitialTimestamp: &snapshot.initialTimestamp,
 		}
+
+		snapshot.after = params.PageCursor
+	}
+
+	if err := snapshot.position.Validate(); err != nil {
+		cancel()
+
+		return nil, fmt.Errorf("validate position: %w", err)
+	}
+
+	// records sent before the first checkpoint carry the starting position.
+	var err error
+	snapshot.sdkPosition, err = snapshot.position.marshalSDKPosition(snapshot.binaryPosition)
+	if err != nil {
+		cancel()
+
+		return nil, fmt.Errorf("marshal sdk position: %w", err)
+	}
+
+	if snapshot.bulkExport {
+		// the export is processed asynchronously, so there are items until it's done.
+		snapshot.exporting.Store(true)
+		snapshot.logEstimatedTotal(ctx)
+
+		go snapshot.export(ctx)
+
+		return snapshot, nil
 	}
 
 	if err := snapshot.loadRecords(ctx); err != nil {
+		cancel()
+
 		return nil, fmt.Errorf("initial load record: %w", err)
 	}
 
+	snapshot.logEstimatedTotal(ctx)
+
+	// if there are no items to snapshot, the snapshot is complete,
+	// so we don't need to poll the resource anymore.
+	if snapshot.skipEmpty && len(snapshot.records) == 0 && !snapshot.hasMoreItems {
+		sdk.Logger(ctx).Debug().Msgf("snapshot of %q is empty, skipping it", snapshot.resource)
+
+		return snapshot, nil
+	}
+
 	go snapshot.poll(ctx)
 
 	return snapshot, nil
 }
 
+// EstimateTotal returns an approximate number of items that are created before the initialTimestamp.
+// For timestamp-based resources that don't return the total field the method returns zero.
+func (s *Snapshot) EstimateTotal(ctx context.Context) (int, error) {
+	if _, ok := hubspot.TimestampResources[s.resource]; ok {
+		listResponse, err := s.list(ctx, &hubspot.ListOptions{
+			Limit:         1,
+			CreatedBefore: &s.initialTimestamp,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("list timestamp items: %w", err)
+		}
+
+		return listResponse.Total, nil
+	}
+
+	if searchResource, ok := hubspot.SearchResources[s.resource]; ok {
+		if s.sortBy == SortByUpdatedAt {
+			listResponse, err := s.searchByUpdatedBefore(ctx, searchResource, 1, "", nil)
+			if err != nil {
+				return 0, fmt.Errorf("list search items: %w", err)
+			}
+
+			return listResponse.Total, nil
+		}
+
+		listResponse, err := s.hubspotClient.SearchByCreatedBefore(ctx, s.resource, s.initialTimestamp, 1, "", nil, "")
+		if err != nil {
+			return 0, fmt.Errorf("list search items: %w", err)
+		}
+
+		return listResponse.Total, nil
+	}
+
+	// this shouldn't happen because we have validation
+	return 0, &hubspot.UnsupportedResourceError{
+		Resource: s.resource,
+	}
+}
+
+// logEstimatedTotal logs an approximate number of items to process.
+// If the initial load retrieved all the items, their number is logged without estimating.
+// The bulk export doesn't load items initially, so the number is always estimated for it.
+// The estimated total is used only for logging, so the method doesn't fail if it cannot be retrieved.
+func (s *Snapshot) logEstimatedTotal(ctx context.Context) {
+	total := len(s.records)
+	if s.hasMoreItems || s.bulkExport {
+		var err error
+
+		total, err = s.EstimateTotal(ctx)
+		if err != nil {
+			sdk.Logger(ctx).Warn().Err(err).Msgf("estimate total number of %q items", s.resource)
+
+			// the total is unknown, so we warn about the default snapshot only if it's expected to always happen.
+			total = 0
+		} else {
+			sdk.Logger(ctx).Info().Msgf("snapshot: approximately %d records to process", total)
+		}
+	} else {
+		sdk.Logger(ctx).Info().Msgf("snapshot: approximately %d records to process", total)
+	}
+
+	if s.warnDefault && total >= s.warnThreshold {
+		sdk.Logger(ctx).Info().Msgf("snapshot of %q is enabled by default, "+
+			"consider setting snapshot to false if you've already performed an initial sync", s.resource)
+	}
+}
+
 // HasNext returns a bool indicating whether the iterator has the next record to return or not.
 func (s *Snapshot) HasNext(_ context.Context) (bool, error) {
-	return len(s.records) > 0 || s.hasMoreItems, nil
+	return len(s.records) > 0 || s.hasMoreItems || s.exporting.Load(), nil
 }
 
 // Next returns the next record.
@@ -107,83 +365,194 @@ func (s *Snapshot) Next(ctx context.Context) (opencdc.Record, error) {
 	}
 }
 
-// Stop stops the iterator.
+// Len returns the number of records that are loaded and wait to be returned.
+func (s *Snapshot) Len() int {
+	return len(s.records)
+}
+
+// Stop stops the iterator by canceling its context,
+// so that requests to HubSpot that are in progress are aborted as well.
 func (s *Snapshot) Stop() {
-	s.stopC <- struct{}{}
+	s.cancel()
+
+	// the stopped iterator won't load its remaining items.
+	s.metrics.addSnapshotRemaining(-s.reportedRemaining.Swap(0))
 }
 
-// poll polls items at the specified time intervals.
+// poll polls items at the specified time intervals,
+// each of them is extended by a random jitter if the pollingJitter is set.
 func (s *Snapshot) poll(ctx context.Context) {
-	ticker := time.NewTicker(s.pollingPeriod)
+	timer := time.NewTimer(pollingInterval(s.pollingPeriod, s.pollingJitter))
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
 
-		case <-s.stopC:
-			return
-
-		case <-ticker.C:
+		case <-timer.C:
 			if err := s.loadRecords(ctx); err != nil {
+				if ctx.Err() != nil {
+					// the iterator has been stopped while loading records.
+					return
+				}
+
 				s.errC <- fmt.Errorf("load records: %w", err)
 			}
+
+			timer.Reset(pollingInterval(s.pollingPeriod, s.pollingJitter))
 		}
 	}
 }
 
 // loadRecords retrieves a new list of the iterator's resource items.
 func (s *Snapshot) loadRecords(ctx context.Context) error {
+	// the last page of a search-based resource has no next cursor, so it would be loaded again.
+	if s.searchCompleted {
+		return nil
+	}
+
+	defer s.metrics.observePoll(time.Now())
+
 	listResponse, err := s.listItems(ctx)
 	if err != nil {
 		return fmt.Errorf("list %q items: %w", s.resource, err)
 	}
 
-	// if the listResponse.Paging is not nil we can retrieve more items by its next link
-	// if a resource is timestamp-based. If a resource is search-based and there are more items
-	// the listResponse.Paging will be not nil but its nextLink will be empty.
-	// if the listResponse.Paging is nil we'll set the nextLink to an empty string.
-	s.hasMoreItems = listResponse.Paging != nil
-	if s.hasMoreItems {
-		s.nextLink = listResponse.Paging.Next.Link
-	} else {
-		s.nextLink = ""
+	// if a resource is timestamp-based we can retrieve more items by the next link of the response.
+	// If a resource is search-based and there are more items the next link will be empty,
+	// and the items are retrieved by the cursor of the next page.
+	s.hasMoreItems = listResponse.HasMore() || listResponse.NextLink() != ""
+	s.nextLink = listResponse.NextLink()
+
+	s.reportRemaining(listResponse)
+
+	// positions of search-based items hold the cursor of their page, so that it's loaded again on restart,
+	// except the last item of the page that holds the cursor of the next page.
+	pageAfter := s.after
+	if _, ok := hubspot.SearchResources[s.resource]; ok {
+		if listResponse.HasMore() {
+			s.after = listResponse.NextAfter()
+		} else {
+			s.searchCompleted = true
+		}
+	} else if s.after != "" {
+		// the page cursor of timestamp-based resources is used only for the first page,
+		// the next ones are listed by the next links and the positions hold the items' timestamps.
+		s.after, pageAfter = "", ""
 	}
 
-	for _, item := range listResponse.Results {
-		itemCreatedAt, err := item.GetCreatedAt(s.resource)
+	for i, item := range listResponse.Results {
+		itemTimestamp, err := s.getItemTimestamp(item)
 		if err != nil {
-			return fmt.Errorf("get item's update date: %w", err)
+			return err
 		}
 
-		newPosition, err := s.getItemPosition(item, itemCreatedAt)
-		if err != nil {
-			return fmt.Errorf("get item's position: %w", err)
+		after := pageAfter
+		lastItem := i == len(listResponse.Results)-1
+		if lastItem {
+			after = s.after
+		}
+
+		// the next page is listed starting from the position, so the last record of a page always updates it.
+		if err := s.sendRecord(ctx, item, itemTimestamp, after, lastItem); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// reportRemaining updates the number of items that are left to snapshot in the metrics
+// based on the total of a provided list response and the number of the loaded items.
+func (s *Snapshot) reportRemaining(listResponse *hubspot.ListResponse) {
+	s.loaded += int64(len(listResponse.Results))
+
+	var remaining int64
+	if s.hasMoreItems && listResponse.Total > 0 {
+		remaining = max(int64(listResponse.Total)-s.loaded, 0)
+	}
+
+	s.metrics.addSnapshotRemaining(remaining - s.reportedRemaining.Swap(remaining))
+}
+
+// sendRecord constructs a snapshot record from a provided item and sends it to the records channel.
+// The position is moved to the item and the search cursor every checkpointInterval records,
+// or if the checkpoint is forced, the other records carry the last checkpointed position.
+func (s *Snapshot) sendRecord(
+	ctx context.Context,
+	item hubspot.ListResponseResult,
+	timestamp time.Time,
+	after string,
+	forceCheckpoint bool,
+) error {
+	newPosition, err := s.getItemPosition(item, timestamp)
+	if err != nil {
+		return fmt.Errorf("get item's position: %w", err)
+	}
 
+	s.uncheckpointed++
+	if forceCheckpoint || s.uncheckpointed >= s.checkpointInterval {
 		s.position.Timestamp = newPosition.Timestamp
 		s.position.ItemID = newPosition.ItemID
+		s.position.After = after
 
-		sdkPosition, err := s.position.MarshalSDKPosition()
+		s.sdkPosition, err = s.position.marshalSDKPosition(s.binaryPosition)
 		if err != nil {
 			return fmt.Errorf("marshal sdk position: %w", err)
 		}
 
-		metadata, err := s.getItemMetadata(item)
-		if err != nil {
-			return fmt.Errorf("get item's metadata: %w", err)
+		s.uncheckpointed = 0
+	}
+
+	metadata, err := s.getItemMetadata(item)
+	if err != nil {
+		return fmt.Errorf("get item's metadata: %w", err)
+	}
+
+	key, err := newRecordKey(item, s.recordKeyField, newPosition.ItemID)
+	if err != nil {
+		return fmt.Errorf("get item's key: %w", err)
+	}
+
+	item = s.filterProperties(item)
+	if s.includeTranscript {
+		if err := addCallTranscript(ctx, s.hubspotClient, item); err != nil {
+			return fmt.Errorf("add call's transcript: %w", err)
 		}
+	}
+
+	payload, err := newRecordPayload(item, s.rawPayload)
+	if err != nil {
+		return fmt.Errorf("get item's payload: %w", err)
+	}
+
+	if err := acquire(ctx, s.semaphore, s.maxBufferWait); err != nil {
+		return fmt.Errorf("acquire semaphore: %w", err)
+	}
 
-		s.records <- sdk.Util.Source.NewRecordSnapshot(
-			sdkPosition, metadata,
-			opencdc.StructuredData{hubspot.ResultsFieldID: s.position.ItemID},
-			opencdc.StructuredData(item),
-		)
+	record := sdk.Util.Source.NewRecordSnapshot(s.sdkPosition, metadata, key, payload)
+
+	if err := send(ctx, s.records, record, s.maxBufferWait); err != nil {
+		release(s.semaphore)
+
+		return fmt.Errorf("send record: %w", err)
 	}
 
 	return nil
 }
 
+// filterProperties filters the item's properties by the property allowlist and denylist,
+// and removes the system properties if the excludeSystemProperties is enabled.
+func (s *Snapshot) filterProperties(item hubspot.ListResponseResult) hubspot.ListResponseResult {
+	item = filterProperties(item, s.propertyAllowlist, s.propertyDenylist)
+	if s.excludeSystemProperties {
+		item = removeSystemProperties(item)
+	}
+
+	return item
+}
+
 // listItems returns items depending on what resource it is.
 // It supports both timestamp- and search-based resources.
 func (s *Snapshot) listItems(ctx context.Context) (*hubspot.ListResponse, error) {
@@ -191,8 +560,8 @@ func (s *Snapshot) listItems(ctx context.Context) (*hubspot.ListResponse, error)
 		return s.listTimestampBasedItems(ctx, resource)
 	}
 
-	if _, ok := hubspot.SearchResources[s.resource]; ok {
-		return s.listSearchBasedItems(ctx)
+	if resource, ok := hubspot.SearchResources[s.resource]; ok {
+		return s.listSearchBasedItems(ctx, resource)
 	}
 
 	// this shouldn't happen because we have validation
@@ -202,6 +571,7 @@ func (s *Snapshot) listItems(ctx context.Context) (*hubspot.ListResponse, error)
 }
 
 // listTimestampBasedItems retrieves timestamp-based items using limit, after and createdBefore query parameters.
+// The after cursor is set only if the snapshot starts from the initial page cursor.
 // The createdBefore parameter is equal to the [Snapshot]'s initialTimestamp value.
 func (s *Snapshot) listTimestampBasedItems(
 	ctx context.Context,
@@ -219,11 +589,16 @@ func (s *Snapshot) listTimestampBasedItems(
 	listOpts := &hubspot.ListOptions{
 		Limit:         s.bufferSize,
 		CreatedBefore: &s.initialTimestamp,
+		After:         s.after,
 		CreatedAfter:  s.position.Timestamp,
 		Sort:          resource.CreatedAtFieldName,
 	}
 
-	listResponse, err := s.hubspotClient.List(ctx, s.resource, listOpts)
+	if s.orderBy != "" {
+		listOpts.Sort = s.orderBy
+	}
+
+	listResponse, err := s.list(ctx, listOpts)
 	if err != nil {
 		return nil, fmt.Errorf("list timestamp items: %w", err)
 	}
@@ -231,24 +606,52 @@ func (s *Snapshot) listTimestampBasedItems(
 	return listResponse, nil
 }
 
-// listSearchBasedItems retrieves search-based items using limit, after and createdBefore filters.
+// list retrieves timestamp-based items using the provided options.
+// The blog posts and site pages are filtered by their publication state if the blogPostState
+// or the sitePageState is set, and the URL redirects are searched by the filter if it's set.
+// The thread messages are listed by the conversationThreadID.
+func (s *Snapshot) list(ctx context.Context, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+	switch {
+	case s.resource == hubspot.BlogPostsResource && s.blogPostState != "":
+		return s.hubspotClient.ListBlogPosts(ctx, &hubspot.BlogPostsListOptions{
+			ListOptions: *opts,
+			State:       s.blogPostState,
+		})
+
+	case s.resource == hubspot.SitePagesResource && s.sitePageState != "":
+		return s.hubspotClient.ListSitePages(ctx, &hubspot.SitePagesListOptions{
+			ListOptions: *opts,
+			State:       s.sitePageState,
+		})
+
+	case s.resource == hubspot.URLRedirectsResource && s.filter != "":
+		return s.hubspotClient.SearchURLRedirects(ctx, s.filter, opts)
+
+	case s.resource == hubspot.ConversationMessagesResource:
+		return s.hubspotClient.GetConversationMessages(ctx, s.conversationThreadID, opts)
+	}
+
+	return s.hubspotClient.List(ctx, s.resource, opts)
+}
+
+// listSearchBasedItems retrieves search-based items using limit, after cursor and createdBefore filters.
 // The createdBefore parameter is equal to the [Snapshot]'s initialTimestamp value.
-func (s *Snapshot) listSearchBasedItems(ctx context.Context) (*hubspot.ListResponse, error) {
-	var after int
-	if s.position != nil && s.position.ItemID != "" {
-		// add one here in order to skip
-		// this particular item and start from the next one.
-		// HubSpot uses integer item ids within strings, so this should never fail.
-		itemIDInt, err := strconv.Atoi(s.position.ItemID)
+// If the sortBy is the SortByUpdatedAt, items are filtered and sorted by their last update date instead.
+func (s *Snapshot) listSearchBasedItems(
+	ctx context.Context,
+	resource hubspot.SearchResource,
+) (*hubspot.ListResponse, error) {
+	if s.sortBy == SortByUpdatedAt {
+		listResponse, err := s.searchByUpdatedBefore(ctx, resource, s.bufferSize, s.after, s.extraProperties)
 		if err != nil {
-			return nil, fmt.Errorf("convert position item id into int: %w", err)
+			return nil, fmt.Errorf("list search items by update date: %w", err)
 		}
 
-		after = itemIDInt + 1
+		return listResponse, nil
 	}
 
 	listResponse, err := s.hubspotClient.SearchByCreatedBefore(
-		ctx, s.resource, s.initialTimestamp, s.bufferSize, after, s.extraProperties,
+		ctx, s.resource, s.initialTimestamp, s.bufferSize, s.after, s.extraProperties, s.orderBy,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("list search items: %w", err)
@@ -257,6 +660,52 @@ func (s *Snapshot) listSearchBasedItems(ctx context.Context) (*hubspot.ListRespo
 	return listResponse, nil
 }
 
+// searchByUpdatedBefore searches items that were last updated before the initialTimestamp,
+// sorting them ascendingly by their update date and then by their ids,
+// so that items with the same update date don't change their places between pages.
+func (s *Snapshot) searchByUpdatedBefore(
+	ctx context.Context,
+	resource hubspot.SearchResource,
+	limit int,
+	after string,
+	properties []string,
+) (*hubspot.ListResponse, error) {
+	return s.hubspotClient.SearchByMultipleFields(ctx, s.resource,
+		[]hubspot.SearchRequestFilterGroupFilter{{
+			PropertyName: resource.UpdatedAtSortName,
+			Operator:     hubspot.LTEOperator,
+			Value:        strconv.Itoa(int(s.initialTimestamp.UnixMilli())),
+		}},
+		[]hubspot.SearchRequestSort{
+			{PropertyName: resource.UpdatedAtSortName, Direction: hubspot.ASCSortDirection},
+			{PropertyName: resource.ObjectIDFilterName, Direction: hubspot.ASCSortDirection},
+		},
+		limit,
+		hubspot.WithSearchProperties(properties),
+		hubspot.WithSearchAfter(after),
+	)
+}
+
+// getItemTimestamp returns the item's timestamp the snapshot position is based on.
+// If the sortBy is the SortByUpdatedAt, it's the item's last update date, otherwise its creation date.
+func (s *Snapshot) getItemTimestamp(item hubspot.ListResponseResult) (time.Time, error) {
+	if s.sortBy == SortByUpdatedAt {
+		itemUpdatedAt, err := item.GetUpdatedAt(s.resource)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("get item's update date: %w", err)
+		}
+
+		return itemUpdatedAt, nil
+	}
+
+	itemCreatedAt, err := item.GetCreatedAt(s.resource)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get item's creation date: %w", err)
+	}
+
+	return itemCreatedAt, nil
+}
+
 // getItemPosition grabs an id field from a provided item and constructs a [Position] based on its value.
 func (s *Snapshot) getItemPosition(item map[string]any, timestamp time.Time) (*Position, error) {
 	itemID, ok := item[hubspot.ResultsFieldID].(string)
@@ -272,7 +721,8 @@ func (s *Snapshot) getItemPosition(item map[string]any, timestamp time.Time) (*P
 	}, nil
 }
 
-// getItemMetadata grabs a createdAt field from a provided item and constructs a [opencdc.Metadata] based on that.
+// getItemMetadata grabs a createdAt field from a provided item and constructs a [opencdc.Metadata]
+// based on that and the resource.
 // If the createdAt field is empty the method will use the current time.
 func (s *Snapshot) getItemMetadata(item map[string]any) (metadata opencdc.Metadata, err error) {
 	createdAt := time.Now()
@@ -284,8 +734,5 @@ func (s *Snapshot) getItemMetadata(item map[string]any) (metadata opencdc.Metada
 		}
 	}
 
-	metadata = make(opencdc.Metadata)
-	metadata.SetCreatedAt(createdAt)
-
-	return metadata, nil
+	return newMetadata(s.resource, s.resourceAlias, createdAt), nil
 }
@@ -0,0 +1,1260 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot/mock"
+	"github.com/conduitio/conduit-commons/opencdc"
+	"github.com/matryer/is"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+// testSnapshotSearchResponse is a search response that contains a single contact.
+const testSnapshotSearchResponse = `{"total": 1, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", ` +
+	`"properties": {"email": "bob@example.com", "hs_object_id": "1"}}]}`
+
+const testSnapshotSearchUpdatedResponse = `{"total": 1, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", ` +
+	`"updatedAt": "2022-11-02T09:15:00Z", "properties": {"email": "bob@example.com", "hs_object_id": "1"}}]}`
+
+// expectSnapshotSearch sets up the mock client to return the response for the initial load of contacts.
+func expectSnapshotSearch(t *testing.T, client *mock.MockClientInterface, response string) {
+	t.Helper()
+
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+		Return(testListResponse(t, response), nil)
+}
+
+func TestSnapshot_Next_propertyDenylist(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:    client,
+		Resource:         "crm.contacts",
+		BufferSize:       10,
+		PollingPeriod:    time.Hour,
+		PropertyDenylist: []string{"hs_object_id"},
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "bob@example.com"})
+}
+
+func TestSnapshot_Next_excludeSystemProperties(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:           client,
+		Resource:                "crm.contacts",
+		BufferSize:              10,
+		PollingPeriod:           time.Hour,
+		RecordKeyField:          "hs_object_id",
+		ExcludeSystemProperties: true,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	// the key is resolved before the system properties are removed.
+	is.Equal(record.Key, opencdc.StructuredData{"hs_object_id": "1"})
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"email": "bob@example.com"})
+}
+
+func TestSnapshot_Next_rawPayload(t *testing.T) {
+	t.Parallel()
+
+	wantItem := map[string]any{
+		"id":         "1",
+		"createdAt":  "2022-10-28T14:58:27Z",
+		"properties": map[string]any{"email": "bob@example.com", "hs_object_id": "1"},
+	}
+
+	tests := []struct {
+		name       string
+		rawPayload bool
+	}{
+		{
+			name:       "structured",
+			rawPayload: false,
+		},
+		{
+			name:       "json",
+			rawPayload: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			snapshot, err := NewSnapshot(ctx, SnapshotParams{
+				HubSpotClient: client,
+				Resource:      "crm.contacts",
+				BufferSize:    10,
+				PollingPeriod: time.Hour,
+				RawPayload:    tt.rawPayload,
+			})
+			is.NoErr(err)
+			t.Cleanup(snapshot.Stop)
+
+			record, err := snapshot.Next(ctx)
+			is.NoErr(err)
+
+			// the key is structured regardless of the payload format.
+			is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+
+			if !tt.rawPayload {
+				payload, ok := record.Payload.After.(opencdc.StructuredData)
+				is.True(ok)
+				is.Equal(map[string]any(payload), wantItem)
+
+				return
+			}
+
+			payload, ok := record.Payload.After.(opencdc.RawData)
+			is.True(ok)
+
+			var item map[string]any
+			is.NoErr(json.Unmarshal(payload, &item))
+			is.Equal(item, wantItem)
+		})
+	}
+}
+
+func TestSnapshot_Next_includeTranscript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		resource       string
+		wantProperties map[string]any
+	}{
+		{
+			name:     "calls",
+			resource: "crm.calls",
+			wantProperties: map[string]any{
+				"hs_call_title": "Discovery call",
+				"transcript":    "Hello, this is Bob.",
+			},
+		},
+		{
+			// the transcript is ignored by the other resources, e.g. within a resource group.
+			name:     "other_resource",
+			resource: "crm.meetings",
+			wantProperties: map[string]any{
+				"hs_call_title": "Discovery call",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			client.EXPECT().
+				SearchByCreatedBefore(gomock.Any(), tt.resource, gomock.Any(), 10, "", gomock.Any(), "").
+				Return(testListResponse(t, `{"total": 1, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", `+
+					`"properties": {"hs_call_title": "Discovery call"}}]}`), nil)
+
+			if tt.resource == "crm.calls" {
+				client.EXPECT().GetCallTranscript(gomock.Any(), "1").Return("Hello, this is Bob.", nil)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			snapshot, err := NewSnapshot(ctx, SnapshotParams{
+				HubSpotClient:     client,
+				Resource:          tt.resource,
+				BufferSize:        10,
+				PollingPeriod:     time.Hour,
+				IncludeTranscript: true,
+			})
+			is.NoErr(err)
+			t.Cleanup(snapshot.Stop)
+
+			record, err := snapshot.Next(ctx)
+			is.NoErr(err)
+
+			payload, ok := record.Payload.After.(opencdc.StructuredData)
+			is.True(ok)
+
+			is.Equal(payload["properties"], tt.wantProperties)
+		})
+	}
+}
+
+func TestSnapshot_Next_emptyPropertyDenylist(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload, opencdc.StructuredData{
+		"id":        "1",
+		"createdAt": "2022-10-28T14:58:27Z",
+		"properties": map[string]any{
+			"email":        "bob@example.com",
+			"hs_object_id": "1",
+		},
+	})
+}
+
+func TestSnapshot_Next_propertyAllowlist(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:     client,
+		Resource:          "crm.contacts",
+		BufferSize:        10,
+		PollingPeriod:     time.Hour,
+		PropertyAllowlist: []string{"hs_object_id"},
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	payload, ok := record.Payload.After.(opencdc.StructuredData)
+	is.True(ok)
+
+	is.Equal(payload["properties"], map[string]any{"hs_object_id": "1"})
+}
+
+func TestSnapshot_Next_searchBasedOrderBy(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "email").
+		Return(testListResponse(t, testSnapshotSearchResponse), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		OrderBy:       "email",
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+}
+
+func TestSnapshot_Next_sortBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		sortBy        string
+		wantTimestamp time.Time
+		expect        func(t *testing.T, client *mock.MockClientInterface)
+	}{
+		{
+			name:          "created_at",
+			sortBy:        SortByCreatedAt,
+			wantTimestamp: time.Date(2022, 10, 28, 14, 58, 27, 0, time.UTC),
+			expect: func(t *testing.T, client *mock.MockClientInterface) {
+				client.EXPECT().
+					SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", []string{"phone"}, "").
+					Return(testListResponse(t, testSnapshotSearchResponse), nil)
+			},
+		},
+		{
+			name:          "updated_at",
+			sortBy:        SortByUpdatedAt,
+			wantTimestamp: time.Date(2022, 11, 2, 9, 15, 0, 0, time.UTC),
+			expect: func(t *testing.T, client *mock.MockClientInterface) {
+				client.EXPECT().
+					SearchByMultipleFields(gomock.Any(), "crm.contacts", gomock.Any(), gomock.Any(), 10, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						filters []hubspot.SearchRequestFilterGroupFilter,
+						sorts []hubspot.SearchRequestSort,
+						_ int,
+						opts ...hubspot.SearchOption,
+					) (*hubspot.ListResponse, error) {
+						if len(filters) != 1 || filters[0].PropertyName != "lastmodifieddate" ||
+							filters[0].Operator != hubspot.LTEOperator {
+							t.Errorf("expected a single lastmodifieddate LTE filter, but got %v", filters)
+						}
+
+						wantSorts := []hubspot.SearchRequestSort{
+							{PropertyName: "lastmodifieddate", Direction: hubspot.ASCSortDirection},
+							{PropertyName: "hs_object_id", Direction: hubspot.ASCSortDirection},
+						}
+						if fmt.Sprint(sorts) != fmt.Sprint(wantSorts) {
+							t.Errorf("expected sorts to be %v, but got %v", wantSorts, sorts)
+						}
+
+						req := &hubspot.SearchRequest{}
+						for _, opt := range opts {
+							opt(req)
+						}
+
+						if strings.Join(req.Properties, ",") != "phone" || req.After != "" {
+							t.Errorf("expected properties [phone] and an empty cursor, but got %v", req)
+						}
+
+						return testListResponse(t, testSnapshotSearchUpdatedResponse), nil
+					})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			tt.expect(t, client)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			snapshot, err := NewSnapshot(ctx, SnapshotParams{
+				HubSpotClient:   client,
+				Resource:        "crm.contacts",
+				BufferSize:      10,
+				PollingPeriod:   time.Hour,
+				ExtraProperties: []string{"phone"},
+				SortBy:          tt.sortBy,
+			})
+			is.NoErr(err)
+			t.Cleanup(snapshot.Stop)
+
+			record, err := snapshot.Next(ctx)
+			is.NoErr(err)
+
+			is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+
+			// the position timestamp comes from the field the items are sorted by.
+			recordPosition, err := ParsePosition(record.Position)
+			is.NoErr(err)
+			is.True(recordPosition.Timestamp != nil)
+			is.True(recordPosition.Timestamp.Equal(tt.wantTimestamp))
+		})
+	}
+}
+
+func TestSnapshot_Next_timestampBasedOrderBy(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		List(gomock.Any(), "cms.blogs.authors", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			if opts.Sort != "fullName" {
+				t.Errorf("expected sort to be %q, but got %q", "fullName", opts.Sort)
+			}
+
+			return testListResponse(t, `{"total": 1, "results": [{"id": "1", "created": "2022-10-28T14:58:27Z", `+
+				`"fullName": "Bob"}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "cms.blogs.authors",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		OrderBy:       "fullName",
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+}
+
+func TestSnapshot_Next_pageCursor(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		List(gomock.Any(), "cms.blogs.authors", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			if opts.After != "NTI1Cg==" {
+				t.Errorf("expected after to be %q, but got %q", "NTI1Cg==", opts.After)
+			}
+
+			return testListResponse(t, `{"total": 1, "results": [{"id": "1", "created": "2022-10-28T14:58:27Z", `+
+				`"fullName": "Bob"}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "cms.blogs.authors",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		PageCursor:    "NTI1Cg==",
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	// the cursor is used only for the first page, so positions don't hold it.
+	position, err := ParsePosition(record.Position)
+	is.NoErr(err)
+	is.Equal(position.After, "")
+	is.Equal(position.ItemID, "1")
+}
+
+func TestSnapshot_Next_blogPostState(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		ListBlogPosts(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts *hubspot.BlogPostsListOptions) (*hubspot.ListResponse, error) {
+			if opts.State != hubspot.BlogPostStatePublished {
+				t.Errorf("expected state to be %q, but got %q", hubspot.BlogPostStatePublished, opts.State)
+			}
+
+			if opts.Sort != "created" {
+				t.Errorf("expected sort to be %q, but got %q", "created", opts.Sort)
+			}
+
+			return testListResponse(t, `{"total": 1, "results": [{"id": "1", "created": "2022-10-28T14:58:27Z", `+
+				`"state": "PUBLISHED"}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      hubspot.BlogPostsResource,
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		BlogPostState: hubspot.BlogPostStatePublished,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+}
+
+func TestSnapshot_Next_sitePageState(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		ListSitePages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts *hubspot.SitePagesListOptions) (*hubspot.ListResponse, error) {
+			if opts.State != hubspot.SitePageStateDraft {
+				t.Errorf("expected state to be %q, but got %q", hubspot.SitePageStateDraft, opts.State)
+			}
+
+			if opts.Sort != "createdAt" {
+				t.Errorf("expected sort to be %q, but got %q", "createdAt", opts.Sort)
+			}
+
+			return testListResponse(t, `{"total": 1, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", `+
+				`"state": "DRAFT"}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      hubspot.SitePagesResource,
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		SitePageState: hubspot.SitePageStateDraft,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+}
+
+func TestSnapshot_Next_urlRedirectsFilter(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		SearchURLRedirects(gomock.Any(), "/blog", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			if opts.Sort != "createdAt" {
+				t.Errorf("expected sort to be %q, but got %q", "createdAt", opts.Sort)
+			}
+
+			return testListResponse(t, `{"total": 1, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", `+
+				`"routePrefix": "/blog"}]}`), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      hubspot.URLRedirectsResource,
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Filter:        "/blog",
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+}
+
+func TestSnapshot_Next_conversationMessages(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		GetConversationMessages(gomock.Any(), "42", gomock.Any()).
+		Return(testListResponse(t, `{"results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", `+
+			`"updatedAt": "2022-10-28T14:58:27Z", "text": "hello"}]}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:        client,
+		Resource:             hubspot.ConversationMessagesResource,
+		ConversationThreadID: "42",
+		BufferSize:           10,
+		PollingPeriod:        time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+}
+
+func TestSnapshot_metrics(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	client.EXPECT().
+		List(gomock.Any(), "cms.blogs.authors", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, opts *hubspot.ListOptions) (*hubspot.ListResponse, error) {
+			// the estimated total is requested with the limit of one item.
+			if opts.Limit == 1 {
+				return testListResponse(t, `{"total": 5, "results": []}`), nil
+			}
+
+			return testListResponse(t, `{"total": 5, "results": [`+
+				`{"id": "1", "created": "2022-10-28T14:58:27Z"}, `+
+				`{"id": "2", "created": "2022-10-28T14:58:28Z"}], `+
+				`"paging": {"next": {"after": "2", "link": "https://api.hubapi.com/cms/v3/blogs/authors?after=2"}}}`), nil
+		}).
+		Times(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var metrics Metrics
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "cms.blogs.authors",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		Metrics:       &metrics,
+	})
+	is.NoErr(err)
+
+	is.Equal(metrics.SnapshotRemaining(), int64(3))
+	is.True(metrics.LastPollDuration() > 0)
+
+	// the stopped snapshot won't load the remaining items.
+	snapshot.Stop()
+
+	is.Equal(metrics.SnapshotRemaining(), int64(0))
+}
+
+func TestSnapshot_HasNext_skipEmpty(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the resource is loaded only once.
+	expectSnapshotSearch(t, client, `{"total": 0, "results": []}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Millisecond,
+		SkipEmpty:     true,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	hasNext, err := snapshot.HasNext(ctx)
+	is.NoErr(err)
+	is.True(!hasNext)
+
+	// wait for a few polling periods to make sure the snapshot doesn't poll the resource.
+	time.Sleep(time.Millisecond * 50)
+}
+
+func TestSnapshot_Stop_cancelsLoad(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+
+	// the initial page has a next page, so that the snapshot keeps polling.
+	expectSnapshotSearch(t, client, `{"total": 0, "results": [], "paging": {"next": {"after": "10"}}}`)
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 1, "", nil, "").
+		Return(testListResponse(t, `{"total": 0, "results": []}`), nil)
+
+	loading := make(chan struct{})
+	loaded := make(chan struct{})
+
+	// the polling request blocks until its context is canceled.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "10", gomock.Any(), "").
+		DoAndReturn(func(ctx context.Context, _ string, _ time.Time, _ int, _ string, _ []string, _ string) (
+			*hubspot.ListResponse, error,
+		) {
+			defer close(loaded)
+
+			close(loading)
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Millisecond,
+	})
+	is.NoErr(err)
+
+	<-loading
+
+	snapshot.Stop()
+
+	select {
+	case <-loaded:
+	case <-time.After(time.Second):
+		t.Fatal("expected the polling request to be canceled")
+	}
+
+	// the cancellation is not reported as an error.
+	nextCtx, nextCancel := context.WithTimeout(ctx, time.Millisecond*50)
+	t.Cleanup(nextCancel)
+
+	_, err = snapshot.Next(nextCtx)
+	is.True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestSnapshot_Next_semaphore(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, `{"total": 2, "results": [`+
+		`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"email": "bob@example.com"}}, `+
+		`{"id": "2", "createdAt": "2022-10-28T14:59:27Z", "properties": {"email": "alice@example.com"}}]}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	semaphore := make(chan struct{}, 1)
+
+	snapshotC := make(chan *Snapshot, 1)
+	go func() {
+		snapshot, err := NewSnapshot(ctx, SnapshotParams{
+			HubSpotClient: client,
+			Resource:      "crm.contacts",
+			BufferSize:    10,
+			PollingPeriod: time.Hour,
+			Semaphore:     semaphore,
+		})
+		if err != nil {
+			t.Errorf("new snapshot: %v", err)
+		}
+
+		snapshotC <- snapshot
+	}()
+
+	// the second record is blocked until the first one is acknowledged.
+	select {
+	case <-snapshotC:
+		t.Fatal("expected the snapshot to block on the semaphore")
+
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	<-semaphore
+
+	snapshot := <-snapshotC
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "1"})
+
+	record, err = snapshot.Next(ctx)
+	is.NoErr(err)
+	is.Equal(record.Key, opencdc.StructuredData{"id": "2"})
+}
+
+func TestNewSnapshot_logEstimatedTotal(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, `{"total": 1234, "results": [{"id": "1", "createdAt": "2022-10-28T14:58:27Z", `+
+		`"properties": {"email": "bob@example.com"}}], "paging": {"next": {"after": "1"}}}`)
+
+	// the estimate request asks for a single item.
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 1, "", nil, "").
+		Return(testListResponse(t, `{"total": 1234, "results": []}`), nil)
+
+	var logs bytes.Buffer
+	logger := zerolog.New(&logs)
+
+	ctx, cancel := context.WithCancel(logger.WithContext(context.Background()))
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	is.True(bytes.Contains(logs.Bytes(), []byte("snapshot: approximately 1234 records to process")))
+}
+
+func TestNewSnapshot_warnDefault(t *testing.T) {
+	t.Parallel()
+
+	const warning = "consider setting snapshot to false"
+
+	tests := []struct {
+		name          string
+		warnDefault   bool
+		warnThreshold int
+		want          bool
+	}{
+		{
+			name:        "warn_zero_threshold",
+			warnDefault: true,
+			want:        true,
+		},
+		{
+			name:          "warn_threshold_reached",
+			warnDefault:   true,
+			warnThreshold: 1,
+			want:          true,
+		},
+		{
+			name:          "no_warn_threshold_not_reached",
+			warnDefault:   true,
+			warnThreshold: 2,
+			want:          false,
+		},
+		{
+			name:        "no_warn_not_default",
+			warnDefault: false,
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			client := newMockClient(t)
+			expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+			var logs bytes.Buffer
+			logger := zerolog.New(&logs)
+
+			ctx, cancel := context.WithCancel(logger.WithContext(context.Background()))
+			t.Cleanup(cancel)
+
+			snapshot, err := NewSnapshot(ctx, SnapshotParams{
+				HubSpotClient: client,
+				Resource:      "crm.contacts",
+				BufferSize:    10,
+				PollingPeriod: time.Hour,
+				WarnDefault:   tt.warnDefault,
+				WarnThreshold: tt.warnThreshold,
+			})
+			is.NoErr(err)
+			t.Cleanup(snapshot.Stop)
+
+			is.Equal(bytes.Contains(logs.Bytes(), []byte(warning)), tt.want)
+		})
+	}
+}
+
+func TestSnapshot_Next_resourceAlias(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		ResourceAlias: "contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	collection, err := record.Metadata.GetCollection()
+	is.NoErr(err)
+	is.Equal(collection, "contacts")
+	is.Equal(record.Metadata[MetadataKeyResource], "crm.contacts")
+}
+
+func TestSnapshot_Next_recordKeyField(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, testSnapshotSearchResponse)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:  client,
+		Resource:       "crm.contacts",
+		RecordKeyField: "email",
+		BufferSize:     10,
+		PollingPeriod:  time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	is.Equal(record.Key, opencdc.StructuredData{"email": "bob@example.com"})
+}
+
+func TestSnapshot_Next_checkpointInterval(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, `{"total": 3, "results": [`+
+		`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"email": "bob@example.com"}}, `+
+		`{"id": "2", "createdAt": "2022-10-28T14:58:28Z", "properties": {"email": "alice@example.com"}}, `+
+		`{"id": "3", "createdAt": "2022-10-28T14:58:29Z", "properties": {"email": "eve@example.com"}}]}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:      client,
+		Resource:           "crm.contacts",
+		BufferSize:         10,
+		PollingPeriod:      time.Hour,
+		CheckpointInterval: 2,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	// the first record carries the starting position, the second one reaches the interval,
+	// and the third one is the last record of the page.
+	for _, wantItemID := range []string{"", "2", "3"} {
+		record, err := snapshot.Next(ctx)
+		is.NoErr(err)
+
+		position, err := ParsePosition(record.Position)
+		is.NoErr(err)
+
+		is.Equal(position.Mode, SnapshotPositionMode)
+		is.Equal(position.ItemID, wantItemID)
+	}
+}
+
+func TestNewSnapshot_bufferTimeout(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	// the page holds more items than the buffer, and nobody reads the records.
+	results := make([]string, 11)
+	for i := range results {
+		results[i] = fmt.Sprintf(`{"id": "%d", "createdAt": "2022-10-28T14:58:27Z"}`, i+1)
+	}
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, fmt.Sprintf(`{"total": 11, "results": [%s]}`, strings.Join(results, ", ")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	_, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		MaxBufferWait: time.Millisecond * 10,
+	})
+	is.True(errors.Is(err, ErrBufferTimeout))
+}
+
+func TestNewSnapshot_semaphoreTimeout(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, `{"total": 2, "results": [`+
+		`{"id": "1", "createdAt": "2022-10-28T14:58:27Z"}, `+
+		`{"id": "2", "createdAt": "2022-10-28T14:59:27Z"}]}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// the buffer has free slots, but nobody acknowledges the first record.
+	_, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+		MaxBufferWait: time.Millisecond * 10,
+		Semaphore:     make(chan struct{}, 1),
+	})
+	is.True(errors.Is(err, ErrBufferTimeout))
+}
+
+func TestSnapshot_Next_binaryPosition(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	expectSnapshotSearch(t, client, `{"total": 1, "results": [`+
+		`{"id": "1", "createdAt": "2022-10-28T14:58:27Z", "properties": {"email": "bob@example.com"}}]}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient:  client,
+		Resource:       "crm.contacts",
+		BufferSize:     10,
+		PollingPeriod:  time.Hour,
+		BinaryPosition: true,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	record, err := snapshot.Next(ctx)
+	is.NoErr(err)
+
+	position, err := ParseBinaryPosition(record.Position)
+	is.NoErr(err)
+
+	is.Equal(position.Mode, SnapshotPositionMode)
+	is.Equal(position.ItemID, "1")
+	is.True(position.InitialTimestamp != nil)
+}
+
+// BenchmarkSnapshot_loadRecords measures allocations per record of loading a page of items,
+// when the position is updated with every record and only with the last record of the page.
+func BenchmarkSnapshot_loadRecords(b *testing.B) {
+	const pageSize = 100
+
+	results := make([]string, pageSize)
+	for i := range results {
+		results[i] = fmt.Sprintf(`{"id": "%d", "createdAt": "2022-10-28T14:58:27Z", `+
+			`"properties": {"email": "bob@example.com", "hs_object_id": "%d"}}`, i+1, i+1)
+	}
+
+	body := fmt.Sprintf(`{"total": %d, "results": [%s]}`, pageSize, strings.Join(results, ", "))
+
+	for _, checkpointInterval := range []int{1, pageSize} {
+		b.Run(fmt.Sprintf("checkpointInterval=%d", checkpointInterval), func(b *testing.B) {
+			client := newMockClient(b)
+			client.EXPECT().
+				SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), pageSize, gomock.Any(), gomock.Any(), "").
+				Return(testListResponse(b, body), nil).
+				AnyTimes()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			b.Cleanup(cancel)
+
+			snapshot, err := NewSnapshot(ctx, SnapshotParams{
+				HubSpotClient:      client,
+				Resource:           "crm.contacts",
+				BufferSize:         pageSize,
+				PollingPeriod:      time.Hour,
+				CheckpointInterval: checkpointInterval,
+			})
+			if err != nil {
+				b.Fatalf("expected error to be nil, but got %v", err)
+			}
+			b.Cleanup(snapshot.Stop)
+
+			var before, after runtime.MemStats
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			runtime.ReadMemStats(&before)
+
+			for i := 0; i < b.N; i++ {
+				// drain the records of the previous load, so that the next one doesn't block.
+				for len(snapshot.records) > 0 {
+					<-snapshot.records
+				}
+
+				if err := snapshot.loadRecords(ctx); err != nil {
+					b.Fatalf("expected error to be nil, but got %v", err)
+				}
+			}
+
+			runtime.ReadMemStats(&after)
+			b.ReportMetric(float64(after.Mallocs-before.Mallocs)/float64(b.N*pageSize), "allocs/record")
+		})
+	}
+}
+
+func TestSnapshot_loadRecords_searchCursor(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	client := newMockClient(t)
+	client.EXPECT().
+		SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 1, "", nil, "").
+		Return(testListResponse(t, `{"total": 4, "results": []}`), nil)
+	gomock.InOrder(
+		client.EXPECT().
+			SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "", gomock.Any(), "").
+			Return(testListResponse(t, `{"total": 4, "results": [`+
+				`{"id": "1", "createdAt": "2022-10-28T14:58:27Z"}, `+
+				`{"id": "2", "createdAt": "2022-10-28T14:58:28Z"}], `+
+				`"paging": {"next": {"after": "2"}}}`), nil),
+		client.EXPECT().
+			SearchByCreatedBefore(gomock.Any(), "crm.contacts", gomock.Any(), 10, "2", gomock.Any(), "").
+			Return(testListResponse(t, `{"total": 4, "results": [`+
+				`{"id": "3", "createdAt": "2022-10-28T14:58:29Z"}, `+
+				`{"id": "4", "createdAt": "2022-10-28T14:58:30Z"}]}`), nil),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	snapshot, err := NewSnapshot(ctx, SnapshotParams{
+		HubSpotClient: client,
+		Resource:      "crm.contacts",
+		BufferSize:    10,
+		PollingPeriod: time.Hour,
+	})
+	is.NoErr(err)
+	t.Cleanup(snapshot.Stop)
+
+	// the second page is loaded by the cursor of the first one,
+	// and the completed search isn't loaded again.
+	is.NoErr(snapshot.loadRecords(ctx))
+	is.NoErr(snapshot.loadRecords(ctx))
+
+	// items hold the cursor of their page, except the last item of a page that isn't the last one.
+	for _, wantAfter := range []string{"", "2", "2", "2"} {
+		record, err := snapshot.Next(ctx)
+		is.NoErr(err)
+
+		position, err := ParsePosition(record.Position)
+		is.NoErr(err)
+
+		is.Equal(position.After, wantAfter)
+	}
+}
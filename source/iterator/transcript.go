@@ -0,0 +1,50 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+)
+
+// transcriptPropertyName is a name of the property the call's transcript is added as.
+const transcriptPropertyName = "transcript"
+
+// addCallTranscript retrieves the transcript of a call item and adds it to the item's properties.
+// Like the [filterProperties], it modifies the item's properties map in place.
+func addCallTranscript(ctx context.Context, client hubspot.ClientInterface, item hubspot.ListResponseResult) error {
+	callID, ok := item[hubspot.ResultsFieldID].(string)
+	if !ok {
+		// this shouldn't happen cause HubSpot API v3 returns items with string identifiers.
+		return ErrItemIDIsNotAString
+	}
+
+	transcript, err := client.GetCallTranscript(ctx, callID)
+	if err != nil {
+		return fmt.Errorf("get call %q transcript: %w", callID, err)
+	}
+
+	properties, ok := item[propertiesFieldName].(map[string]any)
+	if !ok {
+		properties = make(map[string]any)
+		item[propertiesFieldName] = properties
+	}
+
+	properties[transcriptPropertyName] = transcript
+
+	return nil
+}
@@ -18,12 +18,19 @@ package source
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
 	"github.com/conduitio-labs/conduit-connector-hubspot/source/iterator"
+	"github.com/conduitio-labs/conduit-connector-hubspot/validator"
 	cconfig "github.com/conduitio/conduit-commons/config"
 	"github.com/conduitio/conduit-commons/opencdc"
 	sdk "github.com/conduitio/conduit-connector-sdk"
@@ -34,6 +41,9 @@ import (
 type Iterator interface {
 	HasNext(ctx context.Context) (bool, error)
 	Next(ctx context.Context) (opencdc.Record, error)
+	SwitchToSnapshot(ctx context.Context) error
+	SDKPosition() (opencdc.Position, error)
+	Len() int
 	Stop()
 }
 
@@ -43,6 +53,30 @@ type Source struct {
 
 	config   Config
 	iterator Iterator
+	// semaphore limits the number of unacknowledged records by the BufferSize.
+	semaphore chan struct{}
+	// snapshotByDefault is true if the snapshot is enabled because it wasn't configured explicitly.
+	snapshotByDefault bool
+	// hubspotClient is used to retrieve the resource's schema.
+	hubspotClient *hubspot.Client
+	// schemaVersion caches the fingerprint returned by the SchemaVersion method.
+	schemaVersion string
+	// metrics holds the counters returned by the Metrics method.
+	metrics iterator.Metrics
+}
+
+// Metrics holds the telemetry counters of the [Source].
+type Metrics struct {
+	// RecordsRead is the number of records returned by the [Source.Read].
+	RecordsRead uint64
+	// Errors is the number of errors returned by the [Source.Read].
+	Errors uint64
+	// LastPollDuration is the duration of the last poll of the HubSpot API,
+	// including the time it took to queue the loaded records.
+	LastPollDuration time.Duration
+	// SnapshotRemaining is the approximate number of items that are left to snapshot.
+	// It's zero if the snapshot is completed or the resource doesn't report its total.
+	SnapshotRemaining int64
 }
 
 // NewSource creates a new instance of the [Source].
@@ -60,33 +94,223 @@ func (s *Source) Parameters() cconfig.Parameters {
 		},
 		config.KeyResource: {
 			Default:     "",
-			Description: "The name of a HubSpot resource the connector will work with.",
-			Validations: []cconfig.Validation{cconfig.ValidationRequired{}},
+			Description: "The name of a HubSpot resource the connector will work with. Required unless resourceGroup or resources is set.",
+		},
+		ConfigKeyResourceGroup: {
+			Default: "",
+			Description: "The name of a group of HubSpot resources the connector will read from, instead of the resource. " +
+				"One of crm.engagements, which reads crm.calls, crm.emails, crm.meetings, crm.notes, and crm.tasks.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{ResourceGroupEngagements}},
+			},
+		},
+		ConfigKeyResources: {
+			Default: "",
+			Description: "The comma-separated list of HubSpot resources the connector will read from in turns, " +
+				"instead of the resource. It cannot be used together with the resource or the resourceGroup.",
 		},
 		config.KeyMaxRetries: {
 			Default: "4",
 			Description: "The number of HubSpot API request retries " +
 				"that will be tried before giving up if a request fails.",
 		},
+		config.KeyInitialRetryDelay: {
+			Default:     "1s",
+			Description: "The minimum time to wait before retrying a failed request.",
+		},
+		config.KeyMaxRetryDelay: {
+			Default:     "30s",
+			Description: "The maximum time to wait before retrying a failed request.",
+		},
+		config.KeyRetryOnStatusCodes: {
+			Default: "429,500,503",
+			Description: "The HTTP status codes of HubSpot API responses that trigger a retry. " +
+				"Each of them must be between 400 and 599.",
+		},
+		config.KeyHubDBTableID: {
+			Default:     "",
+			Description: "The id of a HubDB table which rows the connector will work with. Required for cms.hubdb.rows.",
+		},
+		config.KeyInsecureSkipVerify: {
+			Default:     "false",
+			Description: "The field determines whether or not the verification of the HubSpot API TLS certificate is skipped.",
+		},
+		config.KeyHTTPProxy: {
+			Default:     "",
+			Description: "The URL of a proxy that HubSpot API requests are sent through, e.g. http://proxy.example.com:8080.",
+		},
 		ConfigKeyPollingPeriod: {
 			Default:     "5s",
 			Description: "The duration defines a period of polling new items if CDC is not available for a resource.",
 		},
+		ConfigKeyPollingJitter: {
+			Default:     "0s",
+			Description: "The upper bound of a random delay added to each polling period. It must be less than pollingPeriod.",
+		},
 		ConfigKeyBufferSize: {
 			Default: "100",
 			Description: "The buffer size for consumed items. " +
 				"It will also be used as a limit when retrieving items from the HubSpot API.",
 		},
+		ConfigKeyMaxBufferWait: {
+			Default: "0s",
+			Description: "The maximum time to wait for a free slot of the records buffer before the source fails. " +
+				"If it's zero, the source waits until the buffer has room.",
+		},
+		ConfigKeyWarmUpDelay: {
+			Default: "0s",
+			Description: "The time to wait after the snapshot before switching to CDC mode, " +
+				"so that items written during the snapshot get into the HubSpot search index.",
+		},
+		ConfigKeyDedupWindow: {
+			Default: "0s",
+			Description: "The duration within which the same update of an item is emitted only once in CDC mode. " +
+				"If it's zero, the deduplication is disabled.",
+		},
 		ConfigKeyExtraProperties: {
 			Default: "",
 			Description: "The list of HubSpot resource properties to include in addition to the default. " +
 				"If any of the specified properties are not present on the requested HubSpot resource, " +
 				"they will be ignored. Only CRM resources support this.",
 		},
+		ConfigKeyExtraPropertiesCDC: {
+			Default: "",
+			Description: "The list of HubSpot resource properties to include in addition to the default " +
+				"in CDC mode only. If it's set, it overrides the extraProperties in CDC mode. Only CRM resources support this.",
+		},
 		ConfigKeySnapshot: {
 			Default: "true",
 			Description: "The field determines whether or not the connector " +
-				"will take a snapshot of the entire collection before starting CDC mode.",
+				"will take a snapshot of the entire collection before starting CDC mode. " +
+				"The snapshotOrderBy, snapshotSortBy, snapshotMode, snapshotCheckpointInterval, blogPostState, cmsPageState, " +
+				"snapshotFilter and snapshotPageCursor cannot be set if it's false.",
+		},
+		ConfigKeyPropertyDenylist: {
+			Default:     "",
+			Description: "The list of HubSpot resource properties to exclude from records.",
+		},
+		ConfigKeyPropertyAllowlist: {
+			Default: "",
+			Description: "The list of HubSpot resource properties to keep in records, all the others will be excluded. " +
+				"The allowed properties are requested from the HubSpot API in addition to the extra properties. " +
+				"It cannot be used together with the propertyDenylist.",
+		},
+		ConfigKeyExcludeSystemProperties: {
+			Default: "false",
+			Description: "The field determines whether or not the HubSpot system properties, which names start with hs_, " +
+				"will be removed from records. The recordKeyField can still be one of them, but it won't be in the payload.",
+		},
+		ConfigKeyIncludeTranscript: {
+			Default: "false",
+			Description: "The field determines whether or not the transcript of each call is retrieved " +
+				"and added to its properties as transcript. Only the crm.calls resource supports this.",
+		},
+		ConfigKeySnapshotOrderBy: {
+			Default: "",
+			Description: "The field name by which items are sorted during the snapshot. " +
+				"By default, items are sorted by their creation date.",
+		},
+		ConfigKeySnapshotSortBy: {
+			Default: "",
+			Description: "The date by which items are sorted and filtered during the snapshot. One of createdAt, updatedAt. " +
+				"With updatedAt, the snapshot reads the items last updated before it started, and the ones updated " +
+				"while it's taken are read by CDC afterwards, so some items can be read twice. " +
+				"Only search-based resources support updatedAt.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{SnapshotSortByCreatedAt, SnapshotSortByUpdatedAt}},
+			},
+		},
+		ConfigKeySkipEmptySnapshot: {
+			Default: "false",
+			Description: "The field determines whether or not the connector " +
+				"will switch to CDC mode right away if the snapshot doesn't contain any items.",
+		},
+		ConfigKeySnapshotWarningThreshold: {
+			Default: "0",
+			Description: "The number of items above which the connector suggests disabling the snapshot " +
+				"if it's enabled by default. If it's zero, the suggestion is always logged.",
+			Validations: []cconfig.Validation{cconfig.ValidationGreaterThan{V: -1}},
+		},
+		ConfigKeySnapshotMode: {
+			Default: defaultSnapshotMode,
+			Description: "The field determines how the snapshot retrieves items. One of search, bulk. " +
+				"The bulk mode uses the HubSpot export API and is supported only by CRM resources.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{SnapshotModeSearch, SnapshotModeBulk}},
+			},
+		},
+		ConfigKeyBlogPostState: {
+			Default: "",
+			Description: "The publication state by which the snapshot filters blog posts. One of DRAFT, SCHEDULED, PUBLISHED. " +
+				"Only cms.blogs.posts supports it. If it's empty, posts of all states are read.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{
+					string(hubspot.BlogPostStateDraft),
+					string(hubspot.BlogPostStateScheduled),
+					string(hubspot.BlogPostStatePublished),
+				}},
+			},
+		},
+		ConfigKeyCMSPageState: {
+			Default: "",
+			Description: "The publication state by which the snapshot filters site pages. One of DRAFT, PUBLISHED. " +
+				"Only cms.pages.site supports it. If it's empty, pages of all states are read.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{
+					string(hubspot.SitePageStateDraft),
+					string(hubspot.SitePageStatePublished),
+				}},
+			},
+		},
+		ConfigKeySnapshotFilter: {
+			Default: "",
+			Description: "The query by which the snapshot searches items instead of listing them all. " +
+				"Only cms.urlRedirects supports it.",
+		},
+		ConfigKeySnapshotPageCursor: {
+			Default: "",
+			Description: "The pagination cursor, e.g. from HubSpot API logs, the snapshot starts from " +
+				"if there's no position. It cannot be used with resourceGroup, several resources or the bulk snapshotMode.",
+		},
+		ConfigKeyResourceAlias: {
+			Default: "",
+			Description: "The name that replaces the resource in the collection metadata of records. " +
+				"It cannot contain dots. If it's empty, the resource is used as is.",
+		},
+		ConfigKeyRecordKeyField: {
+			Default: defaultRecordKeyField,
+			Description: "The field which value becomes the record key. " +
+				"It's looked up among the item's fields first, and then among its properties.",
+		},
+		ConfigKeyConversationThreadID: {
+			Default:     "",
+			Description: "The id of a conversation thread which messages the connector will read. Required for conversations.messages.",
+		},
+		ConfigKeySnapshotCheckpointInterval: {
+			Default: "1",
+			Description: "The number of snapshot records after which the position is updated. " +
+				"The last record of each page always updates it.",
+		},
+		ConfigKeyPositionFormat: {
+			Default: defaultPositionFormat,
+			Description: "The format positions are marshaled in. One of json, proto. " +
+				"The proto format makes positions smaller, positions of both formats are read regardless of it.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{PositionFormatJSON, PositionFormatProto}},
+			},
+		},
+		ConfigKeyRecordFormat: {
+			Default: defaultRecordFormat,
+			Description: "The format record payloads are emitted in. One of structured, json. " +
+				"The json format emits each item as JSON-encoded raw data, record keys are structured regardless of it.",
+			Validations: []cconfig.Validation{
+				cconfig.ValidationInclusion{List: []string{RecordFormatStructured, RecordFormatJSON}},
+			},
+		},
+		ConfigKeyValidateExtraProperties: {
+			Default: "false",
+			Description: "The field determines whether or not the connector " +
+				"will check that all the extraProperties exist on the resource when it's opened.",
 		},
 	}
 }
@@ -98,6 +322,8 @@ func (s *Source) Configure(_ context.Context, cfg cconfig.Config) (err error) {
 		return fmt.Errorf("parse source config: %w", err)
 	}
 
+	s.snapshotByDefault = s.config.Snapshot && cfg[ConfigKeySnapshot] == ""
+
 	return nil
 }
 
@@ -105,24 +331,109 @@ func (s *Source) Configure(_ context.Context, cfg cconfig.Config) (err error) {
 func (s *Source) Open(ctx context.Context, sdkPosition opencdc.Position) error {
 	retryableHTTPClient := retryablehttp.NewClient()
 	retryableHTTPClient.RetryMax = s.config.MaxRetries
+	retryableHTTPClient.RetryWaitMin = s.config.InitialRetryDelay
+	retryableHTTPClient.RetryWaitMax = s.config.MaxRetryDelay
+	retryableHTTPClient.CheckRetry = s.config.CheckRetry
 	retryableHTTPClient.Logger = sdk.Logger(ctx)
 
+	if transport := s.config.HTTPTransport(); transport != nil {
+		retryableHTTPClient.HTTPClient.Transport = transport
+	}
+
 	hubspotClient := hubspot.NewClient(s.config.AccessToken, retryableHTTPClient.StandardClient())
+	hubspotClient.SetHubDBResource(hubspot.HubDBResource{
+		TableID: s.config.HubDBTableID,
+	})
+
+	s.hubspotClient = hubspotClient
+
+	resource := s.config.Resource
+	switch {
+	case s.config.ResourceGroup != "":
+		resource = s.config.ResourceGroup
+	case len(s.config.Resources) > 0:
+		resource = strings.Join(s.config.Resources, ",")
+	}
+
+	// the portal info is only logged, so the source doesn't fail if it cannot be retrieved,
+	// and an invalid access token is rejected by the first request for the resource anyway.
+	openedEvent := sdk.Logger(ctx).Info()
+
+	portalInfo, err := hubspotClient.GetPortalInfo(ctx)
+	if err != nil {
+		sdk.Logger(ctx).Warn().Err(err).Msg("failed to retrieve the portal info, skipping the portal id")
+	} else {
+		openedEvent = openedEvent.Int("portalId", portalInfo.PortalID)
+	}
+
+	openedEvent.Msgf("opened source for %q", resource)
+
+	s.checkTokenScopes(ctx, hubspotClient)
+
+	if s.config.ValidateExtraProperties {
+		if err := s.validateExtraProperties(ctx); err != nil {
+			return fmt.Errorf("validate extra properties: %w", err)
+		}
+	}
+
+	s.semaphore = make(chan struct{}, s.config.BufferSize)
+
+	params := iterator.CombinedParams{
+		HubSpotClient:              hubspotClient,
+		Resource:                   s.config.Resource,
+		ResourceAlias:              s.config.ResourceAlias,
+		RecordKeyField:             s.config.RecordKeyField,
+		ConversationThreadID:       s.config.ConversationThreadID,
+		BufferSize:                 s.config.BufferSize,
+		PollingPeriod:              s.config.PollingPeriod,
+		PollingJitter:              s.config.PollingJitter,
+		ExtraProperties:            s.config.requestedProperties(),
+		CDCExtraProperties:         s.config.requestedCDCProperties(),
+		PropertyAllowlist:          s.config.PropertyAllowlist,
+		PropertyDenylist:           s.config.PropertyDenylist,
+		ExcludeSystemProperties:    s.config.ExcludeSystemProperties,
+		Snapshot:                   s.config.Snapshot,
+		SnapshotOrderBy:            s.config.SnapshotOrderBy,
+		SnapshotSortBy:             s.config.SnapshotSortBy,
+		SnapshotBlogPostState:      s.config.BlogPostState,
+		SnapshotSitePageState:      s.config.CMSPageState,
+		SnapshotFilter:             s.config.SnapshotFilter,
+		SkipEmptySnapshot:          s.config.SkipEmptySnapshot,
+		BulkSnapshot:               s.config.SnapshotMode == SnapshotModeBulk,
+		SnapshotCheckpointInterval: s.config.SnapshotCheckpointInterval,
+		SnapshotPageCursor:         s.config.SnapshotPageCursor,
+		BinaryPosition:             s.config.PositionFormat == PositionFormatProto,
+		RawPayload:                 s.config.RecordFormat == RecordFormatJSON,
+		IncludeTranscript:          s.config.IncludeTranscript,
+		MaxBufferWait:              s.config.MaxBufferWait,
+		WarmUpDelay:                s.config.WarmUpDelay,
+		DedupWindow:                s.config.DedupWindow,
+		Semaphore:                  s.semaphore,
+		Metrics:                    &s.metrics,
+		// suggest disabling the snapshot only if it's enabled by default and it's going to start from scratch.
+		WarnDefaultSnapshot:      s.snapshotByDefault && len(sdkPosition) == 0,
+		SnapshotWarningThreshold: s.config.SnapshotWarningThreshold,
+	}
 
-	position, err := iterator.ParsePosition(sdkPosition)
+	if s.config.ResourceGroup != "" || len(s.config.Resources) > 0 {
+		s.iterator, err = iterator.NewMultiResourceCombined(ctx, iterator.MultiResourceCombinedParams{
+			Resources: s.config.resources(),
+			Position:  sdkPosition,
+			Params:    params,
+		})
+		if err != nil {
+			return fmt.Errorf("initialize multi-resource combined iterator: %w", err)
+		}
+
+		return nil
+	}
+
+	params.Position, err = iterator.ParsePosition(sdkPosition)
 	if err != nil && !errors.Is(err, iterator.ErrEmptyPosition) {
 		return fmt.Errorf("parse position: %w", err)
 	}
 
-	s.iterator, err = iterator.NewCombined(ctx, iterator.CombinedParams{
-		HubSpotClient:   hubspotClient,
-		Resource:        s.config.Resource,
-		BufferSize:      s.config.BufferSize,
-		PollingPeriod:   s.config.PollingPeriod,
-		Position:        position,
-		ExtraProperties: s.config.ExtraProperties,
-		Snapshot:        s.config.Snapshot,
-	})
+	s.iterator, err = iterator.NewCombined(ctx, params)
 	if err != nil {
 		return fmt.Errorf("initialize combined iterator: %w", err)
 	}
@@ -130,11 +441,74 @@ func (s *Source) Open(ctx context.Context, sdkPosition opencdc.Position) error {
 	return nil
 }
 
+// validateExtraProperties checks that all the ExtraProperties exist on each of the resources.
+// The method returns an *[InvalidPropertyError] for the first unknown property.
+func (s *Source) validateExtraProperties(ctx context.Context) error {
+	if len(s.config.ExtraProperties) == 0 {
+		return nil
+	}
+
+	for _, resource := range s.config.resources() {
+		properties, err := s.hubspotClient.GetProperties(ctx, resource)
+		if err != nil {
+			return fmt.Errorf("get %q properties: %w", resource, err)
+		}
+
+		existing := make(map[string]struct{}, len(properties))
+		for _, property := range properties {
+			existing[property.Name] = struct{}{}
+		}
+
+		for _, name := range s.config.ExtraProperties {
+			if _, ok := existing[name]; !ok {
+				return &InvalidPropertyError{
+					Name:     name,
+					Resource: resource,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTokenScopes logs the scopes granted to the access token and warns about the ones
+// the configured resources require but the token lacks.
+// It doesn't fail, because only private app tokens can be inspected and
+// HubSpot is the one to reject a request if a scope is really missing.
+func (s *Source) checkTokenScopes(ctx context.Context, hubspotClient *hubspot.Client) {
+	tokenInfo, err := hubspotClient.WhoAmI(ctx)
+	if err != nil {
+		sdk.Logger(ctx).Warn().Err(err).Msg("failed to retrieve the access token scopes, skipping the scope check")
+
+		return
+	}
+
+	sdk.Logger(ctx).Debug().Strs("scopes", tokenInfo.ScopesGranted).Msg("access token scopes granted")
+
+	for _, resource := range s.config.resources() {
+		for _, scope := range hubspot.RequiredScopes(resource, validator.OperationList) {
+			if !slices.Contains(tokenInfo.ScopesGranted, scope) {
+				sdk.Logger(ctx).Warn().
+					Str("resource", resource).
+					Str("scope", scope).
+					Msgf("the access token is missing the %q scope required to read the %q resource", scope, resource)
+			}
+		}
+	}
+}
+
 // Read fetches a new record from an iterator.
 // If there's no record the method will return the [sdk.ErrBackoffRetry].
 func (s *Source) Read(ctx context.Context) (opencdc.Record, error) {
+	defer s.logMetrics(ctx)
+
+	sdk.Logger(ctx).Trace().Int("queueDepth", s.QueueDepth()).Msg("reading a record")
+
 	hasNext, err := s.iterator.HasNext(ctx)
 	if err != nil {
+		s.metrics.IncErrors()
+
 		return opencdc.Record{}, fmt.Errorf("has next: %w", err)
 	}
 
@@ -144,16 +518,151 @@ func (s *Source) Read(ctx context.Context) (opencdc.Record, error) {
 
 	record, err := s.iterator.Next(ctx)
 	if err != nil {
+		s.metrics.IncErrors()
+
 		return opencdc.Record{}, fmt.Errorf("get next record: %w", err)
 	}
 
+	s.metrics.IncRecordsRead()
+
 	return record, nil
 }
 
-// Ack does nothing. We don't need acks for the Snapshot or CDC iterators.
+// QueueDepth returns the number of records that are loaded from HubSpot and wait in the iterator's buffer.
+// It's zero if the source isn't opened. Unlike the [Source.Metrics], it's not safe to call it
+// concurrently with the [Source.Read], because the iterator can be switched while reading.
+func (s *Source) QueueDepth() int {
+	if s.iterator == nil {
+		return 0
+	}
+
+	return s.iterator.Len()
+}
+
+// Metrics returns the current values of the source telemetry counters.
+// It's safe to call it concurrently with the [Source.Read].
+func (s *Source) Metrics() Metrics {
+	return Metrics{
+		RecordsRead:       s.metrics.RecordsRead(),
+		Errors:            s.metrics.Errors(),
+		LastPollDuration:  s.metrics.LastPollDuration(),
+		SnapshotRemaining: s.metrics.SnapshotRemaining(),
+	}
+}
+
+// logMetrics logs the source telemetry counters at the debug level.
+func (s *Source) logMetrics(ctx context.Context) {
+	metrics := s.Metrics()
+
+	sdk.Logger(ctx).Debug().
+		Uint64("recordsRead", metrics.RecordsRead).
+		Uint64("errors", metrics.Errors).
+		Dur("lastPollDuration", metrics.LastPollDuration).
+		Int64("snapshotRemaining", metrics.SnapshotRemaining).
+		Msg("source metrics")
+}
+
+// Reset makes the source snapshot the resource again without restarting the pipeline,
+// for example after the resource's schema has been changed.
+func (s *Source) Reset(ctx context.Context) error {
+	if s.iterator == nil {
+		return ErrSourceNotOpened
+	}
+
+	if err := s.iterator.SwitchToSnapshot(ctx); err != nil {
+		return fmt.Errorf("switch to snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// SchemaVersion returns a fingerprint of the schemas of the resources, which is a SHA-256 hex digest
+// of the sorted resources and the sorted names of their properties.
+// It changes only when resources or properties are added or removed.
+// Only the CRM resources have property definitions, so the other resources are fingerprinted by their names only.
+// The fingerprint is retrieved once and cached.
+func (s *Source) SchemaVersion(ctx context.Context) (string, error) {
+	if s.schemaVersion != "" {
+		return s.schemaVersion, nil
+	}
+
+	if s.hubspotClient == nil {
+		return "", ErrSourceNotOpened
+	}
+
+	properties := make(map[string][]hubspot.PropertyDefinition)
+	for _, resource := range s.config.resources() {
+		if _, ok := hubspot.ResourcesSchemaPaths[resource]; !ok {
+			properties[resource] = nil
+
+			continue
+		}
+
+		schema, err := s.hubspotClient.GetSchema(ctx, resource)
+		if err != nil {
+			return "", fmt.Errorf("get %q schema: %w", resource, err)
+		}
+
+		properties[resource] = schema.Properties
+	}
+
+	s.schemaVersion = schemaFingerprint(properties)
+
+	return s.schemaVersion, nil
+}
+
+// CurrentPosition returns the position of the last record returned by the [Source.Read]
+// marshaled in the configured position format, so that it can be checkpointed without reading a record.
+// If no records have been read, the position the source was opened with is returned,
+// which is nil if the source started from scratch.
+func (s *Source) CurrentPosition() (opencdc.Position, error) {
+	if s.iterator == nil {
+		return nil, ErrSourceNotOpened
+	}
+
+	sdkPosition, err := s.iterator.SDKPosition()
+	if err != nil {
+		return nil, fmt.Errorf("get iterator position: %w", err)
+	}
+
+	return sdkPosition, nil
+}
+
+// schemaFingerprint returns a SHA-256 hex digest of the sorted resources
+// and the sorted names of the properties of each of them.
+func schemaFingerprint(properties map[string][]hubspot.PropertyDefinition) string {
+	resources := slices.Sorted(maps.Keys(properties))
+
+	hash := sha256.New()
+	for _, resource := range resources {
+		names := make([]string, len(properties[resource]))
+		for i, property := range properties[resource] {
+			names[i] = property.Name
+		}
+
+		slices.Sort(names)
+
+		// the separators prevent different sets of names from producing the same digest,
+		// and the indentation keeps property names apart from resources.
+		hash.Write([]byte(resource + "\n"))
+		for _, name := range names {
+			hash.Write([]byte("\t" + name + "\n"))
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Ack releases a semaphore slot acquired for the acknowledged record,
+// so that the iterators can queue a new record.
 func (s *Source) Ack(ctx context.Context, position opencdc.Position) error {
 	sdk.Logger(ctx).Debug().Str("position", string(position)).Msg("got ack")
 
+	select {
+	case <-s.semaphore:
+	default:
+	}
+
 	return nil
 }
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/conduitio-labs/conduit-connector-hubspot/config"
 	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
+	"github.com/conduitio-labs/conduit-connector-hubspot/source/iterator"
 	"github.com/conduitio-labs/conduit-connector-hubspot/test"
 	"github.com/conduitio/conduit-commons/opencdc"
 	sdk "github.com/conduitio/conduit-connector-sdk"
@@ -38,6 +39,9 @@ var (
 	// testAccessToken will be used if a provided access token is empty,
 	// if both a provided access token and this value are empty an integration test will be skipped.
 	testAccessToken = os.Getenv("HUBSPOT_ACCESS_TOKEN")
+	// testConversationThreadID is an id of an existing conversation thread with at least one message,
+	// if it's empty conversation messages integration tests will be skipped.
+	testConversationThreadID = os.Getenv("HUBSPOT_CONVERSATION_THREAD_ID")
 	// testHTTPClientTimeout is a HTTP timeout for test HTTP client.
 	testHTTPClientTimeout = 5 * time.Second
 
@@ -84,6 +88,7 @@ func TestSource_Read_successSnapshot(t *testing.T) {
 	is.Equal(record.Operation, opencdc.OperationSnapshot)
 
 	compareTestContactWithRecord(is, testContact, record)
+	checkRecordMetadata(is, record)
 
 	cancel()
 	err = source.Teardown(context.Background())
@@ -190,6 +195,7 @@ func TestSource_Read_successCDC(t *testing.T) {
 	is.Equal(record.Operation, opencdc.OperationSnapshot)
 
 	compareTestContactWithRecord(is, firstTestContact, record)
+	checkRecordMetadata(is, record)
 
 	// we expect backoff retry and switch to CDC mode here
 	_, err = source.Read(ctx)
@@ -209,6 +215,7 @@ func TestSource_Read_successCDC(t *testing.T) {
 	is.Equal(record.Operation, opencdc.OperationCreate)
 
 	secondTestContactID := compareTestContactWithRecord(is, secondTestContact, record)
+	checkRecordMetadata(is, record)
 
 	// update the second test contact
 	updatedTestContact := trc.NewTestUpdateRecord(secondTestContactID)
@@ -219,6 +226,7 @@ func TestSource_Read_successCDC(t *testing.T) {
 	is.Equal(record.Operation, opencdc.OperationUpdate)
 
 	compareTestContactWithRecord(is, updatedTestContact, record)
+	checkRecordMetadata(is, record)
 
 	cancel()
 	err = source.Teardown(context.Background())
@@ -262,9 +270,74 @@ func TestSource_Read_failInvalidToken(t *testing.T) {
 	err = source.Open(ctx, nil)
 	is.True(err != nil)
 
-	var unexpectedStatusCode *hubspot.UnexpectedStatusCodeError
-	is.True(errors.As(err, &unexpectedStatusCode))
-	is.Equal(unexpectedStatusCode.StatusCode, http.StatusUnauthorized)
+	is.True(errors.Is(err, hubspot.ErrUnauthorized))
+}
+
+func TestSource_Open_failNonexistentExtraProperty(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	// prepare a config with a nonexistent extra property and its validation enabled
+	config := prepareConfig(t, "")
+	config[ConfigKeyExtraProperties] = "nonexistent_property"
+	config[ConfigKeyValidateExtraProperties] = "true"
+
+	source := NewSource()
+
+	err := source.Configure(ctx, config)
+	is.NoErr(err)
+
+	err = source.Open(ctx, nil)
+	is.True(err != nil)
+
+	var invalidPropertyErr *InvalidPropertyError
+	is.True(errors.As(err, &invalidPropertyErr))
+	is.Equal(invalidPropertyErr.Name, "nonexistent_property")
+	is.Equal(invalidPropertyErr.Resource, testResource)
+}
+
+func TestSource_Read_successConversationMessagesSnapshot(t *testing.T) {
+	is := is.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if testConversationThreadID == "" {
+		t.Skip("HUBSPOT_CONVERSATION_THREAD_ID env var must be set")
+	}
+
+	// prepare a config for the conversation messages resource
+	cfg := prepareConfig(t, "")
+	cfg[config.KeyResource] = hubspot.ConversationMessagesResource
+	cfg[ConfigKeyConversationThreadID] = testConversationThreadID
+
+	source := NewSource()
+
+	err := source.Configure(ctx, cfg)
+	is.NoErr(err)
+
+	// make sure the thread has messages to compare with
+	hubspotClient := hubspot.NewClient(testAccessToken, &http.Client{
+		Timeout: testHTTPClientTimeout,
+	})
+
+	messages, err := hubspotClient.GetConversationMessages(ctx, testConversationThreadID, &hubspot.ListOptions{
+		Limit: 1,
+	})
+	is.NoErr(err)
+	is.True(len(messages.Results) > 0)
+
+	err = source.Open(ctx, nil)
+	is.NoErr(err)
+
+	record, err := readWithRetry(ctx, source)
+	is.NoErr(err)
+
+	is.Equal(record.Operation, opencdc.OperationSnapshot)
+	checkRecordMetadata(is, record)
+
+	cancel()
+	err = source.Teardown(context.Background())
+	is.NoErr(err)
 }
 
 func prepareConfig(t *testing.T, accessToken string) map[string]string {
@@ -296,7 +369,7 @@ func waitTestContacts(
 	filterGroups := make([]hubspot.SearchRequestFilterGroup, len(wantRecs))
 	for i, wantRec := range wantRecs {
 		sd := wantRec.Payload.After.(opencdc.StructuredData)
-		properties := sd["properties"].(map[string]any)
+		properties := hubspot.ListResponseResult(sd).Properties()
 		filters := make([]hubspot.SearchRequestFilterGroupFilter, 0)
 		for k, v := range properties {
 			filters = append(filters, hubspot.SearchRequestFilterGroupFilter{
@@ -351,6 +424,18 @@ func readWithRetry(ctx context.Context, source sdk.Source) (opencdc.Record, erro
 	return record, err
 }
 
+// checkRecordMetadata checks that a record's metadata contains the HubSpot-specific fields.
+func checkRecordMetadata(is *is.I, record opencdc.Record) {
+	is.Helper()
+
+	collection, err := record.Metadata.GetCollection()
+	is.NoErr(err)
+	is.Equal(collection, testResource)
+
+	is.Equal(record.Metadata[iterator.MetadataKeyResource], testResource)
+	is.Equal(record.Metadata[iterator.MetadataKeyObjectType], "contacts")
+}
+
 // compareTestContactWithRecord parses and compares a testContact
 // represented as a map[string]any with a record's payload.
 // The method returns the test contact's id as a string.
@@ -365,8 +450,8 @@ func compareTestContactWithRecord(
 	gotPayload, ok := got.Payload.After.(opencdc.StructuredData)
 	is.True(ok)
 
-	gotProperties, ok := gotPayload["properties"].(map[string]any)
-	is.True(ok)
+	gotProperties := hubspot.ListResponseResult(gotPayload).Properties()
+	is.True(len(gotProperties) > 0)
 
 	// take the object's id to delete the object when the test is completed
 	hsObjectID, ok := gotProperties["hs_object_id"].(string)
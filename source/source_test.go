@@ -17,23 +17,27 @@ package source
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/conduitio-labs/conduit-connector-hubspot/config"
+	"github.com/conduitio-labs/conduit-connector-hubspot/hubspot"
 	"github.com/conduitio-labs/conduit-connector-hubspot/source/mock"
+	"github.com/conduitio-labs/conduit-connector-hubspot/source/testutil"
 	"github.com/conduitio/conduit-commons/opencdc"
+	sdk "github.com/conduitio/conduit-connector-sdk"
 	"github.com/matryer/is"
 	"go.uber.org/mock/gomock"
 )
 
-func TestSource_Read_success(t *testing.T) {
+func TestSource_Read(t *testing.T) {
 	t.Parallel()
 
-	is := is.New(t)
-
-	ctrl := gomock.NewController(t)
-	ctx := context.Background()
-
 	key := make(opencdc.StructuredData)
 	key["id"] = 1
 
@@ -49,55 +53,550 @@ func TestSource_Read_success(t *testing.T) {
 		},
 	}
 
+	errHasNext := errors.New("get data: fail")
+	errNext := errors.New("key is not exist")
+
+	tests := []struct {
+		name        string
+		iterator    *testutil.FakeIterator
+		want        opencdc.Record
+		wantErr     error
+		wantMetrics Metrics
+	}{
+		{
+			name: "success",
+			iterator: &testutil.FakeIterator{
+				HasNextFn: func(context.Context) (bool, error) {
+					return true, nil
+				},
+				NextFn: func(context.Context) (opencdc.Record, error) {
+					return record, nil
+				},
+			},
+			want:        record,
+			wantMetrics: Metrics{RecordsRead: 1},
+		},
+		{
+			name: "success_no_next",
+			iterator: &testutil.FakeIterator{
+				HasNextFn: func(context.Context) (bool, error) {
+					return false, nil
+				},
+			},
+			wantErr: sdk.ErrBackoffRetry,
+		},
+		{
+			name: "fail_has_next",
+			iterator: &testutil.FakeIterator{
+				HasNextFn: func(context.Context) (bool, error) {
+					return true, errHasNext
+				},
+			},
+			wantErr:     errHasNext,
+			wantMetrics: Metrics{Errors: 1},
+		},
+		{
+			name: "fail_next",
+			iterator: &testutil.FakeIterator{
+				HasNextFn: func(context.Context) (bool, error) {
+					return true, nil
+				},
+				NextFn: func(context.Context) (opencdc.Record, error) {
+					return opencdc.Record{}, errNext
+				},
+			},
+			wantErr:     errNext,
+			wantMetrics: Metrics{Errors: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			s := Source{
+				iterator: tt.iterator,
+			}
+
+			got, err := s.Read(context.Background())
+			is.Equal(s.Metrics(), tt.wantMetrics)
+
+			if tt.wantErr != nil {
+				is.True(errors.Is(err, tt.wantErr))
+
+				return
+			}
+
+			is.NoErr(err)
+			is.Equal(got, tt.want)
+		})
+	}
+}
+
+func TestSource_Read_metricsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	const (
+		readers        = 8
+		readsPerReader = 100
+	)
+
+	var calls atomic.Int64
+
+	s := Source{
+		iterator: &testutil.FakeIterator{
+			HasNextFn: func(context.Context) (bool, error) {
+				return true, nil
+			},
+			NextFn: func(context.Context) (opencdc.Record, error) {
+				// every fourth read fails, so that both counters are incremented concurrently.
+				if calls.Add(1)%4 == 0 {
+					return opencdc.Record{}, errors.New("next: fail")
+				}
+
+				return opencdc.Record{}, nil
+			},
+		},
+	}
+
+	var wg sync.WaitGroup
+	for range readers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range readsPerReader {
+				_, _ = s.Read(context.Background())
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	metrics := s.Metrics()
+	is.Equal(metrics.RecordsRead+metrics.Errors, uint64(readers*readsPerReader))
+	is.Equal(metrics.Errors, uint64(readers*readsPerReader/4))
+}
+
+func TestSource_Reset_success(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
 	it := mock.NewMockIterator(ctrl)
-	it.EXPECT().HasNext(ctx).Return(true, nil)
-	it.EXPECT().Next(ctx).Return(record, nil)
+	it.EXPECT().SwitchToSnapshot(ctx).Return(nil)
 
 	s := Source{
 		iterator: it,
 	}
 
-	r, err := s.Read(ctx)
+	err := s.Reset(ctx)
 	is.NoErr(err)
+}
+
+func TestSource_Reset_failNotOpened(t *testing.T) {
+	t.Parallel()
 
-	is.Equal(r, record)
+	is := is.New(t)
+
+	s := Source{}
+
+	err := s.Reset(context.Background())
+	is.True(errors.Is(err, ErrSourceNotOpened))
 }
 
-func TestSource_Read_failHasNext(t *testing.T) {
+func TestSource_QueueDepth(t *testing.T) {
 	t.Parallel()
 
 	is := is.New(t)
 
 	ctrl := gomock.NewController(t)
-	ctx := context.Background()
 
 	it := mock.NewMockIterator(ctrl)
-	it.EXPECT().HasNext(ctx).Return(true, errors.New("get data: fail"))
+	it.EXPECT().Len().Return(7)
 
 	s := Source{
 		iterator: it,
 	}
 
-	_, err := s.Read(ctx)
-	is.True(err != nil)
+	is.Equal(s.QueueDepth(), 7)
 }
 
-func TestSource_Read_failNext(t *testing.T) {
+func TestSource_QueueDepth_notOpened(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	s := Source{}
+
+	is.Equal(s.QueueDepth(), 0)
+}
+
+func TestSource_CurrentPosition(t *testing.T) {
 	t.Parallel()
 
 	is := is.New(t)
 
 	ctrl := gomock.NewController(t)
-	ctx := context.Background()
+
+	sdkPosition := opencdc.Position(`{"mode":"cdc","itemId":"1","timestamp":"2022-10-28T12:00:00Z"}`)
 
 	it := mock.NewMockIterator(ctrl)
-	it.EXPECT().HasNext(ctx).Return(true, nil)
-	it.EXPECT().Next(ctx).Return(opencdc.Record{}, errors.New("key is not exist"))
+	it.EXPECT().SDKPosition().Return(sdkPosition, nil)
 
 	s := Source{
 		iterator: it,
 	}
 
-	_, err := s.Read(ctx)
-	is.True(err != nil)
+	got, err := s.CurrentPosition()
+	is.NoErr(err)
+	is.Equal(got, sdkPosition)
+}
+
+func TestSource_CurrentPosition_failIterator(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	ctrl := gomock.NewController(t)
+
+	errPosition := errors.New("test error")
+
+	it := mock.NewMockIterator(ctrl)
+	it.EXPECT().SDKPosition().Return(nil, errPosition)
+
+	s := Source{
+		iterator: it,
+	}
+
+	_, err := s.CurrentPosition()
+	is.True(errors.Is(err, errPosition))
+}
+
+func TestSource_CurrentPosition_failNotOpened(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	s := Source{}
+
+	_, err := s.CurrentPosition()
+	is.True(errors.Is(err, ErrSourceNotOpened))
+}
+
+func TestSource_Ack_releasesSemaphore(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	s := Source{
+		semaphore: make(chan struct{}, 2),
+	}
+
+	s.semaphore <- struct{}{}
+	s.semaphore <- struct{}{}
+
+	err := s.Ack(context.Background(), opencdc.Position("1"))
+	is.NoErr(err)
+	is.Equal(len(s.semaphore), 1)
+
+	err = s.Ack(context.Background(), opencdc.Position("2"))
+	is.NoErr(err)
+	is.Equal(len(s.semaphore), 0)
+
+	// acknowledging more records than queued must not block.
+	err = s.Ack(context.Background(), opencdc.Position("3"))
+	is.NoErr(err)
+}
+
+func TestSource_SchemaVersion_cached(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path != "/crm/v3/properties/contacts" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"results": [{"name": "firstname"}, {"name": "email"}]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	is.NoErr(err)
+
+	s := Source{
+		config: Config{
+			Config: config.Config{
+				Resource: "crm.contacts",
+			},
+		},
+		hubspotClient: hubspot.NewClient("secret", &http.Client{
+			Transport: &testServerTransport{serverURL: serverURL},
+		}),
+	}
+
+	version, err := s.SchemaVersion(context.Background())
+	is.NoErr(err)
+	is.Equal(version, schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}, {Name: "firstname"}},
+	}))
+
+	cachedVersion, err := s.SchemaVersion(context.Background())
+	is.NoErr(err)
+	is.Equal(cachedVersion, version)
+
+	is.Equal(requests.Load(), int32(1))
+}
+
+func TestSource_SchemaVersion_failNotOpened(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	s := Source{}
+
+	_, err := s.SchemaVersion(context.Background())
+	is.True(errors.Is(err, ErrSourceNotOpened))
+}
+
+func TestSource_SchemaVersion_resources(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch r.URL.Path {
+		case "/crm/v3/properties/contacts":
+			body = `{"results": [{"name": "email"}]}`
+
+		case "/crm/v3/properties/notes":
+			body = `{"results": [{"name": "hs_note_body"}]}`
+
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	is.NoErr(err)
+
+	s := Source{
+		config: Config{
+			Config: config.Config{
+				Resource: "crm.notes",
+			},
+			Resources: []string{"crm.notes", "crm.contacts"},
+		},
+		hubspotClient: hubspot.NewClient("secret", &http.Client{
+			Transport: &testServerTransport{serverURL: serverURL},
+		}),
+	}
+
+	version, err := s.SchemaVersion(context.Background())
+	is.NoErr(err)
+	is.Equal(version, schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}},
+		"crm.notes":    {{Name: "hs_note_body"}},
+	}))
+}
+
+func TestSource_SchemaVersion_nonCRMResources(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/crm/v3/properties/contacts" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"results": [{"name": "email"}]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	is.NoErr(err)
+
+	s := Source{
+		config: Config{
+			Config: config.Config{
+				Resource: "cms.blogs.posts",
+			},
+			Resources: []string{"cms.blogs.posts", "crm.contacts"},
+		},
+		hubspotClient: hubspot.NewClient("secret", &http.Client{
+			Transport: &testServerTransport{serverURL: serverURL},
+		}),
+	}
+
+	// the blog posts have no property definitions, so their schema is not requested.
+	version, err := s.SchemaVersion(context.Background())
+	is.NoErr(err)
+	is.Equal(version, schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"cms.blogs.posts": nil,
+		"crm.contacts":    {{Name: "email"}},
+	}))
+}
+
+func TestSchemaFingerprint(t *testing.T) {
+	t.Parallel()
+
+	is := is.New(t)
+
+	fingerprint := schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}, {Name: "firstname"}},
+	})
+
+	// the order of properties doesn't matter.
+	is.Equal(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "firstname"}, {Name: "email"}},
+	}), fingerprint)
+
+	// only the names of properties matter.
+	is.Equal(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {
+			{Name: "email", Label: "Email"},
+			{Name: "firstname", Label: "First Name"},
+		},
+	}), fingerprint)
+
+	// adding or removing properties changes the fingerprint.
+	is.True(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}},
+	}) != fingerprint)
+	is.True(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}, {Name: "firstname"}, {Name: "lastname"}},
+	}) != fingerprint)
+
+	// the same properties of another resource produce another fingerprint.
+	is.True(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.leads": {{Name: "email"}, {Name: "firstname"}},
+	}) != fingerprint)
+
+	// adding a resource changes the fingerprint.
+	is.True(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "email"}, {Name: "firstname"}},
+		"crm.notes":    {{Name: "hs_note_body"}},
+	}) != fingerprint)
+
+	// property names are separated, so that their concatenations don't collide.
+	is.True(schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "ab"}, {Name: "c"}},
+	}) != schemaFingerprint(map[string][]hubspot.PropertyDefinition{
+		"crm.contacts": {{Name: "a"}, {Name: "bc"}},
+	}))
+}
+
+func TestSource_validateExtraProperties(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"results": [{"name": "firstname"}, {"name": "email"}]}`)); err != nil {
+			t.Errorf("write body: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server url: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		extraProperties []string
+		wantErr         error
+	}{
+		{
+			name:            "success_existing_properties",
+			extraProperties: []string{"email", "firstname"},
+		},
+		{
+			name: "success_no_properties",
+		},
+		{
+			name:            "fail_unknown_property",
+			extraProperties: []string{"email", "nonexistent_property", "other_nonexistent_property"},
+			wantErr: &InvalidPropertyError{
+				Name:     "nonexistent_property",
+				Resource: "crm.contacts",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			is := is.New(t)
+
+			s := Source{
+				config: Config{
+					Config: config.Config{
+						Resource: "crm.contacts",
+					},
+					ExtraProperties: tt.extraProperties,
+				},
+				hubspotClient: hubspot.NewClient("secret", &http.Client{
+					Transport: &testServerTransport{serverURL: serverURL},
+				}),
+			}
+
+			err := s.validateExtraProperties(context.Background())
+			if tt.wantErr == nil {
+				is.NoErr(err)
+
+				return
+			}
+
+			var invalidPropertyErr *InvalidPropertyError
+			is.True(errors.As(err, &invalidPropertyErr))
+			is.Equal(invalidPropertyErr, tt.wantErr)
+		})
+	}
+}
+
+// testServerTransport is an [http.RoundTripper] that sends all requests to a test server.
+type testServerTransport struct {
+	serverURL *url.URL
+}
+
+// RoundTrip replaces a request's scheme and host with the test server's ones.
+func (rt *testServerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = rt.serverURL.Scheme
+	req.URL.Host = rt.serverURL.Host
+
+	return http.DefaultTransport.RoundTrip(req)
 }
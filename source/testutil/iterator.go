@@ -0,0 +1,86 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testutil provides test helpers for the source package.
+package testutil
+
+import (
+	"context"
+
+	"github.com/conduitio/conduit-commons/opencdc"
+)
+
+// FakeIterator is a source iterator that delegates its methods to configurable functions,
+// so tests can simulate iterator behavior without generated mocks.
+// If a function is not set, the corresponding method does nothing and returns zero values.
+type FakeIterator struct {
+	HasNextFn          func(ctx context.Context) (bool, error)
+	NextFn             func(ctx context.Context) (opencdc.Record, error)
+	SwitchToSnapshotFn func(ctx context.Context) error
+	SDKPositionFn      func() (opencdc.Position, error)
+	LenFn              func() int
+	StopFn             func()
+}
+
+// HasNext calls the HasNextFn if it's set.
+func (f *FakeIterator) HasNext(ctx context.Context) (bool, error) {
+	if f.HasNextFn == nil {
+		return false, nil
+	}
+
+	return f.HasNextFn(ctx)
+}
+
+// Next calls the NextFn if it's set.
+func (f *FakeIterator) Next(ctx context.Context) (opencdc.Record, error) {
+	if f.NextFn == nil {
+		return opencdc.Record{}, nil
+	}
+
+	return f.NextFn(ctx)
+}
+
+// SwitchToSnapshot calls the SwitchToSnapshotFn if it's set.
+func (f *FakeIterator) SwitchToSnapshot(ctx context.Context) error {
+	if f.SwitchToSnapshotFn == nil {
+		return nil
+	}
+
+	return f.SwitchToSnapshotFn(ctx)
+}
+
+// SDKPosition calls the SDKPositionFn if it's set.
+func (f *FakeIterator) SDKPosition() (opencdc.Position, error) {
+	if f.SDKPositionFn == nil {
+		return nil, nil
+	}
+
+	return f.SDKPositionFn()
+}
+
+// Len calls the LenFn if it's set.
+func (f *FakeIterator) Len() int {
+	if f.LenFn == nil {
+		return 0
+	}
+
+	return f.LenFn()
+}
+
+// Stop calls the StopFn if it's set.
+func (f *FakeIterator) Stop() {
+	if f.StopFn != nil {
+		f.StopFn()
+	}
+}
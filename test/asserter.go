@@ -78,6 +78,21 @@ func (ra *RecordAsserter) NotExists(ids ...string) {
 	}
 }
 
+// Count returns the number of items that match the provided record.
+func (ra *RecordAsserter) Count(wantRec opencdc.Record) int {
+	ctx := context.Background()
+	listResp, err := ra.client.List(ctx, ra.resource, nil)
+	ra.is.NoErr(err)
+
+	var count int
+	for _, gotResp := range listResp.Results {
+		if ra.isEqual(wantRec, gotResp) {
+			count++
+		}
+	}
+	return count
+}
+
 func (*RecordAsserter) isEqual(want opencdc.Record, got hubspot.ListResponseResult) bool {
 	sd, ok := want.Payload.After.(opencdc.StructuredData)
 	if !ok {
@@ -92,14 +107,7 @@ func (*RecordAsserter) isEqual(want opencdc.Record, got hubspot.ListResponseResu
 		return false
 	}
 
-	gotPropertiesRaw, ok := got["properties"]
-	if !ok {
-		return false
-	}
-	gotProperties, ok := gotPropertiesRaw.(map[string]any)
-	if !ok {
-		return false
-	}
+	gotProperties := got.Properties()
 
 	for k, wantProperty := range wantProperties {
 		if gotProperties[k] != wantProperty {
@@ -85,7 +85,7 @@ func (rc *RecordCreator) NewTestCreateRecord() opencdc.Record {
 
 	if rc.flushToServer {
 		//nolint:forcetypeassert // we just created the record, we can type assert without a check
-		err := rc.client.Create(context.Background(), rc.resource, rec.Payload.After.(opencdc.StructuredData))
+		_, err := rc.client.Create(context.Background(), rc.resource, rec.Payload.After.(opencdc.StructuredData))
 		rc.is.NoErr(err)
 	}
 
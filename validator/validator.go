@@ -32,6 +32,26 @@ const (
 	hubspotResourceTag = "hubspot_resource"
 )
 
+// Operations that can be checked by the [ValidateResourceSupportsOperation] function.
+const (
+	OperationList   = "list"
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+	OperationSearch = "search"
+)
+
+// UnsupportedOperationError occurs when a HubSpot resource doesn't support an operation.
+type UnsupportedOperationError struct {
+	Resource  string
+	Operation string
+}
+
+// Error returns a formated error message for the [UnsupportedOperationError].
+func (e *UnsupportedOperationError) Error() string {
+	return fmt.Sprintf("resource %q doesn't support the %q operation", e.Resource, e.Operation)
+}
+
 var (
 	once sync.Once
 
@@ -56,12 +76,20 @@ func ValidateStruct(data any) error {
 				fieldName := getFieldKey(data, fieldErr.StructField())
 
 				switch fieldErr.Tag() {
-				case "required":
+				case "required", "required_if":
 					err = multierr.Append(err, requiredErr(fieldName))
 				case "gte":
 					err = multierr.Append(err, gteErr(fieldName, fieldErr.Param()))
 				case "lte":
 					err = multierr.Append(err, lteErr(fieldName, fieldErr.Param()))
+				case "gt":
+					err = multierr.Append(err, gtErr(fieldName, fieldErr.Param()))
+				case "lt":
+					err = multierr.Append(err, ltErr(fieldName, fieldErr.Param()))
+				case "gtefield":
+					err = multierr.Append(err, gtefieldErr(fieldName, getFieldKey(data, fieldErr.Param())))
+				case "ltfield":
+					err = multierr.Append(err, ltfieldErr(fieldName, getFieldKey(data, fieldErr.Param())))
 				case hubspotResourceTag:
 					err = multierr.Append(err, hubspotResourceErr(fieldName))
 				}
@@ -73,6 +101,37 @@ func ValidateStruct(data any) error {
 	return err
 }
 
+// ValidateResourceSupportsOperation checks whether a HubSpot resource supports an operation.
+// The operation must be one of "list", "create", "update", "delete", "search".
+// The function returns an *[UnsupportedOperationError] if the resource doesn't support the operation.
+func ValidateResourceSupportsOperation(resource, operation string) error {
+	var ok bool
+
+	switch operation {
+	case OperationList:
+		_, ok = hubspot.ResourcesListPaths[resource]
+	case OperationCreate:
+		_, ok = hubspot.ResourcesCreatePaths[resource]
+	case OperationUpdate:
+		_, ok = hubspot.ResourcesUpdatePaths[resource]
+	case OperationDelete:
+		_, ok = hubspot.ResourcesDeletePaths[resource]
+	case OperationSearch:
+		_, ok = hubspot.SearchResources[resource]
+	default:
+		return fmt.Errorf("unknown operation %q", operation)
+	}
+
+	if !ok {
+		return &UnsupportedOperationError{
+			Resource:  resource,
+			Operation: operation,
+		}
+	}
+
+	return nil
+}
+
 // hubspotResource checks if a field's value is a supported HubSpot resource.
 func hubspotResource(fl validator.FieldLevel) bool {
 	_, ok := hubspot.ResourcesListPaths[fl.Field().String()]
@@ -95,6 +154,26 @@ func lteErr(name, lte string) error {
 	return fmt.Errorf("%q value must be less than or equal to %s", name, lte)
 }
 
+// gtErr returns the formatted gt error.
+func gtErr(name, gt string) error {
+	return fmt.Errorf("%q value must be greater than %s", name, gt)
+}
+
+// ltErr returns the formatted lt error.
+func ltErr(name, lt string) error {
+	return fmt.Errorf("%q value must be less than %s", name, lt)
+}
+
+// gtefieldErr returns the formatted gtefield error.
+func gtefieldErr(name, field string) error {
+	return fmt.Errorf("%q value must be greater than or equal to %q value", name, field)
+}
+
+// ltfieldErr returns the formatted ltfield error.
+func ltfieldErr(name, field string) error {
+	return fmt.Errorf("%q value must be less than %q value", name, field)
+}
+
 // hubspotResourceErr returns the formatted hubspot_resource error.
 func hubspotResourceErr(name string) error {
 	return fmt.Errorf("%q value must be one of the supported HubSpot resources", name)
@@ -14,7 +14,10 @@
 
 package validator
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestValidateStruct(t *testing.T) {
 	t.Parallel()
@@ -50,6 +53,39 @@ func TestValidateStruct(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success_hubspot_resource_marketing_emails",
+			args: args{
+				data: struct {
+					Resource string `key:"resource" validate:"hubspot_resource"`
+				}{
+					Resource: "marketing.emails",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_hubspot_resource_conversations_messages",
+			args: args{
+				data: struct {
+					Resource string `key:"resource" validate:"hubspot_resource"`
+				}{
+					Resource: "conversations.messages",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_hubspot_resource_marketing_form_submissions",
+			args: args{
+				data: struct {
+					Resource string `key:"resource" validate:"hubspot_resource"`
+				}{
+					Resource: "marketing.formSubmissions",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "fail_pointer",
 			args: args{
@@ -122,6 +158,58 @@ func TestValidateStruct(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "fail_gt_and_lt",
+			args: args{
+				data: struct {
+					First  int `key:"first" validate:"gt=0"`
+					Second int `key:"second" validate:"lt=10"`
+				}{
+					First:  0,
+					Second: 10,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail_gtefield",
+			args: args{
+				data: struct {
+					Min int `key:"min"`
+					Max int `key:"max" validate:"gtefield=Min"`
+				}{
+					Min: 2,
+					Max: 1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success_ltfield",
+			args: args{
+				data: struct {
+					Min int `key:"min" validate:"ltfield=Max"`
+					Max int `key:"max"`
+				}{
+					Min: 1,
+					Max: 2,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail_ltfield",
+			args: args{
+				data: struct {
+					Min int `key:"min" validate:"ltfield=Max"`
+					Max int `key:"max"`
+				}{
+					Min: 2,
+					Max: 2,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,3 +224,101 @@ func TestValidateStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResourceSupportsOperation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resource  string
+		operation string
+		wantErr   bool
+	}{
+		{
+			name:      "success_list",
+			resource:  "cms.domains",
+			operation: OperationList,
+		},
+		{
+			name:      "success_create",
+			resource:  "crm.contacts",
+			operation: OperationCreate,
+		},
+		{
+			name:      "success_update",
+			resource:  "crm.contacts",
+			operation: OperationUpdate,
+		},
+		{
+			name:      "success_delete",
+			resource:  "cms.domains",
+			operation: OperationDelete,
+		},
+		{
+			name:      "success_search",
+			resource:  "crm.contacts",
+			operation: OperationSearch,
+		},
+		{
+			name:      "success_create_forms",
+			resource:  "cms.forms",
+			operation: OperationCreate,
+		},
+		{
+			name:      "success_search_postal_mail",
+			resource:  "crm.postalMail",
+			operation: OperationSearch,
+		},
+		{
+			name:      "success_search_task_queues",
+			resource:  "crm.taskQueues",
+			operation: OperationSearch,
+		},
+		{
+			name:      "fail_unsupported_create",
+			resource:  "cms.domains",
+			operation: OperationCreate,
+			wantErr:   true,
+		},
+		{
+			name:      "fail_unsupported_search",
+			resource:  "cms.blogs.authors",
+			operation: OperationSearch,
+			wantErr:   true,
+		},
+		{
+			name:      "fail_unsupported_resource",
+			resource:  "wrong",
+			operation: OperationList,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateResourceSupportsOperation(tt.resource, tt.operation)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateResourceSupportsOperation() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+
+			var unsupportedOperationErr *UnsupportedOperationError
+			if tt.wantErr && !errors.As(err, &unsupportedOperationErr) {
+				t.Errorf("expected error to be UnsupportedOperationError, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateResourceSupportsOperation_unknownOperation(t *testing.T) {
+	t.Parallel()
+
+	if err := ValidateResourceSupportsOperation("crm.contacts", "upsert"); err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}